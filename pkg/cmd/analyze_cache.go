@@ -0,0 +1,344 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"math"
+	"sort"
+	"strconv"
+)
+
+var (
+	hotPartitionFactor float64
+	memberSkewPercent  float64
+)
+
+// hotPartition is a partition with a size or count far above the median.
+type hotPartition struct {
+	PartitionID int32   `json:"partitionId"`
+	MemberID    int32   `json:"memberId"`
+	Count       int32   `json:"count"`
+	TotalSize   int64   `json:"totalSize"`
+	SizeRatio   float64 `json:"sizeRatio"`
+	CountRatio  float64 `json:"countRatio"`
+}
+
+// cacheMemberSkew is the cache data for a storage member and the skew from the average.
+type cacheMemberSkew struct {
+	NodeID     string  `json:"nodeId"`
+	Partitions int     `json:"partitions"`
+	Count      int64   `json:"count"`
+	TotalSize  int64   `json:"totalSize"`
+	UnitsBytes int64   `json:"unitsBytes"`
+	Skew       float64 `json:"skew"`
+	Skewed     bool    `json:"skewed"`
+}
+
+// rebalanceEstimate is the estimated impact of moving partitions to balance the cache data.
+type rebalanceEstimate struct {
+	PartitionsToMove int     `json:"partitionsToMove"`
+	BytesToMove      int64   `json:"bytesToMove"`
+	CurrentMaxSkew   float64 `json:"currentMaxSkew"`
+	EstimatedMaxSkew float64 `json:"estimatedMaxSkew"`
+}
+
+// cacheAnalysis is the result of analyzing the data distribution for a cache.
+type cacheAnalysis struct {
+	ServiceName          string            `json:"serviceName"`
+	CacheName            string            `json:"cacheName"`
+	Partitions           int               `json:"partitions"`
+	MedianPartitionSize  int64             `json:"medianPartitionSize"`
+	MedianPartitionCount int32             `json:"medianPartitionCount"`
+	HotPartitionFactor   float64           `json:"hotPartitionFactor"`
+	MemberSkewPercent    float64           `json:"memberSkewPercent"`
+	HotPartitions        []hotPartition    `json:"hotPartitions"`
+	Members              []cacheMemberSkew `json:"members"`
+	Rebalance            rebalanceEstimate `json:"rebalance"`
+}
+
+// analyzeCacheCmd represents the analyze cache command.
+var analyzeCacheCmd = &cobra.Command{
+	Use:   "cache cache-name",
+	Short: "analyze the data distribution of a cache to find hot partitions and skewed members",
+	Long: `The 'analyze cache' command analyzes the partition and member data for a cache and displays
+hot partitions, where the size or count is more than the hot partition factor times the median,
+and storage members whose data size differs from the average by more than the skew percentage.
+An estimate of the partitions and data that would need to be moved to balance the members is also
+displayed. Hot partitions caused by key affinity cannot be split, so they limit how balanced the
+members can be.`,
+	ValidArgsFunction: completionCaches,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, provideCacheMessage)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			cacheName             = args[0]
+			cachePartitionDetails = config.CachePartitionDetails{}
+			cacheDetails          = config.CacheDetails{}
+		)
+
+		if hotPartitionFactor <= 1 {
+			return errors.New("hot partition factor must be greater than 1")
+		}
+		if memberSkewPercent <= 0 {
+			return errors.New("skew percent must be greater than 0")
+		}
+
+		connection, dataFetcher, err := GetConnectionAndDataFetcher()
+		if err != nil {
+			return err
+		}
+
+		if serviceName, err = findServiceForCacheOrTopic(dataFetcher, cacheName, "cache"); err != nil {
+			return err
+		}
+
+		partitionsResult, err := dataFetcher.GetCachePartitions(serviceName, cacheName)
+		if err != nil {
+			return err
+		}
+
+		if string(partitionsResult) == "{}" || len(partitionsResult) == 0 {
+			return fmt.Errorf(cannotFindCache, cacheName, serviceName)
+		}
+
+		if err = json.Unmarshal(partitionsResult, &cachePartitionDetails); err != nil {
+			return utils.GetError("unable to unmarshall cache partitions", err)
+		}
+
+		membersResult, err := dataFetcher.GetCacheMembers(serviceName, cacheName)
+		if err != nil {
+			return err
+		}
+
+		if err = json.Unmarshal(membersResult, &cacheDetails); err != nil {
+			return utils.GetError("unable to unmarshall cache members", err)
+		}
+
+		analysis := analyzeCacheData(serviceName, cacheName, cachePartitionDetails.Details, cacheDetails.Details)
+
+		if isJSONPathOrJSON() {
+			jsonData, err := json.Marshal(analysis)
+			if err != nil {
+				return err
+			}
+			return processJSONOutput(cmd, jsonData)
+		}
+
+		formattingFunction := getFormattingFunction()
+
+		cmd.Println(FormatCurrentCluster(connection))
+		cmd.Printf("Service:                %s\n", serviceName)
+		cmd.Printf("Cache:                  %s\n", cacheName)
+		cmd.Printf("Partitions:             %d\n", analysis.Partitions)
+		cmd.Printf("Median Partition Size:  %s\n", formattingFunction(analysis.MedianPartitionSize))
+		cmd.Printf("Median Partition Count: %s\n\n", formatSmallInteger(analysis.MedianPartitionCount))
+
+		if len(analysis.HotPartitions) == 0 {
+			cmd.Printf("No partitions are more than %.1f times the median size or count\n\n", hotPartitionFactor)
+		} else {
+			cmd.Printf("Hot partitions, more than %.1f times the median size or count:\n\n", hotPartitionFactor)
+			cmd.Println(FormatHotPartitions(analysis.HotPartitions))
+		}
+
+		cmd.Printf("Storage members, skewed if more than %.1f%% from the average size:\n\n", memberSkewPercent)
+		cmd.Println(FormatCacheMemberSkew(analysis.Members))
+
+		rebalance := analysis.Rebalance
+		cmd.Printf("Rebalance estimate: move %d partitions (%s) to reduce the maximum skew from %.1f%% to %.1f%%\n",
+			rebalance.PartitionsToMove, formattingFunction(rebalance.BytesToMove), rebalance.CurrentMaxSkew, rebalance.EstimatedMaxSkew)
+
+		return nil
+	},
+}
+
+// analyzeCacheData analyzes the partition and member details for a cache.
+func analyzeCacheData(service, cache string, partitions []config.CachePartitionDetail, members []config.CacheDetail) cacheAnalysis {
+	var (
+		analysis = cacheAnalysis{ServiceName: service, CacheName: cache, Partitions: len(partitions),
+			HotPartitionFactor: hotPartitionFactor, MemberSkewPercent: memberSkewPercent,
+			HotPartitions: make([]hotPartition, 0), Members: make([]cacheMemberSkew, 0)}
+		sizes      = make([]int64, 0, len(partitions))
+		counts     = make([]int64, 0, len(partitions))
+		memberData = make(map[string]*cacheMemberSkew)
+		memberSize = make(map[string][]int64)
+	)
+
+	for _, value := range members {
+		if value.Tier != "back" {
+			continue
+		}
+		member, ok := memberData[value.NodeID]
+		if !ok {
+			member = &cacheMemberSkew{NodeID: value.NodeID}
+			memberData[value.NodeID] = member
+		}
+		member.UnitsBytes += value.UnitsBytes
+	}
+
+	for _, value := range partitions {
+		sizes = append(sizes, value.TotalSize)
+		counts = append(counts, int64(value.Count))
+
+		nodeID := fmt.Sprintf("%d", value.MemberID)
+		member, ok := memberData[nodeID]
+		if !ok {
+			member = &cacheMemberSkew{NodeID: nodeID}
+			memberData[nodeID] = member
+		}
+		member.Partitions++
+		member.Count += int64(value.Count)
+		member.TotalSize += value.TotalSize
+		memberSize[nodeID] = append(memberSize[nodeID], value.TotalSize)
+	}
+
+	analysis.MedianPartitionSize = getMedian(sizes)
+	analysis.MedianPartitionCount = int32(getMedian(counts))
+
+	// a median of zero means most partitions are empty, so any data is treated as relative to 1
+	medianSize := float64(max(analysis.MedianPartitionSize, 1))
+	medianCount := float64(max(analysis.MedianPartitionCount, 1))
+
+	for _, value := range partitions {
+		sizeRatio := float64(value.TotalSize) / medianSize
+		countRatio := float64(value.Count) / medianCount
+		if sizeRatio > hotPartitionFactor || countRatio > hotPartitionFactor {
+			analysis.HotPartitions = append(analysis.HotPartitions, hotPartition{PartitionID: value.PartitionID,
+				MemberID: value.MemberID, Count: value.Count, TotalSize: value.TotalSize,
+				SizeRatio: sizeRatio, CountRatio: countRatio})
+		}
+	}
+	sort.Slice(analysis.HotPartitions, func(p, q int) bool {
+		return analysis.HotPartitions[p].TotalSize > analysis.HotPartitions[q].TotalSize
+	})
+
+	var totalSize int64
+	for _, member := range memberData {
+		totalSize += member.TotalSize
+	}
+	averageSize := float64(totalSize) / float64(max(len(memberData), 1))
+
+	for _, member := range memberData {
+		member.Skew = getSkewPercent(int(member.TotalSize), averageSize)
+		member.Skewed = math.Abs(member.Skew) > memberSkewPercent
+		analysis.Members = append(analysis.Members, *member)
+	}
+	sort.Slice(analysis.Members, func(p, q int) bool {
+		nodeID1, _ := strconv.Atoi(analysis.Members[p].NodeID)
+		nodeID2, _ := strconv.Atoi(analysis.Members[q].NodeID)
+		return nodeID1 < nodeID2
+	})
+
+	analysis.Rebalance = estimateRebalance(memberSize, analysis.Members, averageSize)
+
+	return analysis
+}
+
+// estimateRebalance estimates the partitions and bytes to move to balance the members by repeatedly
+// moving a partition from the largest member to the smallest member.
+func estimateRebalance(memberSize map[string][]int64, members []cacheMemberSkew, averageSize float64) rebalanceEstimate {
+	var (
+		estimate = rebalanceEstimate{}
+		loads    = make(map[string]int64)
+		sizes    = make(map[string][]int64)
+		moves    int
+	)
+
+	if len(members) < 2 || averageSize == 0 {
+		return estimate
+	}
+
+	for _, member := range members {
+		loads[member.NodeID] = member.TotalSize
+		sizes[member.NodeID] = append([]int64{}, memberSize[member.NodeID]...)
+		sort.Slice(sizes[member.NodeID], func(p, q int) bool {
+			return sizes[member.NodeID][p] > sizes[member.NodeID][q]
+		})
+		moves += len(memberSize[member.NodeID])
+	}
+
+	getMaxSkew := func() (string, string, float64) {
+		var largest, smallest string
+		for _, member := range members {
+			if largest == "" || loads[member.NodeID] > loads[largest] {
+				largest = member.NodeID
+			}
+			if smallest == "" || loads[member.NodeID] < loads[smallest] {
+				smallest = member.NodeID
+			}
+		}
+		return largest, smallest, getSkewPercent(int(loads[largest]), averageSize)
+	}
+
+	_, _, estimate.CurrentMaxSkew = getMaxSkew()
+
+	for i := 0; i < moves; i++ {
+		largest, smallest, _ := getMaxSkew()
+		difference := loads[largest] - loads[smallest]
+
+		// find the largest partition that is at most half the difference, so the smallest
+		// member does not become larger than the largest member was
+		index := -1
+		for j, size := range sizes[largest] {
+			if size > 0 && size*2 <= difference {
+				index = j
+				break
+			}
+		}
+		if index == -1 {
+			break
+		}
+
+		size := sizes[largest][index]
+		sizes[largest] = append(sizes[largest][:index], sizes[largest][index+1:]...)
+		sizes[smallest] = append(sizes[smallest], size)
+		sort.Slice(sizes[smallest], func(p, q int) bool {
+			return sizes[smallest][p] > sizes[smallest][q]
+		})
+		loads[largest] -= size
+		loads[smallest] += size
+		estimate.PartitionsToMove++
+		estimate.BytesToMove += size
+	}
+
+	_, _, estimate.EstimatedMaxSkew = getMaxSkew()
+
+	return estimate
+}
+
+// getMedian returns the median of the values.
+func getMedian(values []int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]int64{}, values...)
+	sort.Slice(sorted, func(p, q int) bool {
+		return sorted[p] < sorted[q]
+	})
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+func init() {
+	analyzeCacheCmd.Flags().StringVarP(&serviceName, serviceNameOption, serviceNameOptionShort, "", serviceNameDescription)
+	analyzeCacheCmd.Flags().Float64VarP(&hotPartitionFactor, "hot-factor", "F", 3, "times the median size or count a partition must exceed to be hot")
+	analyzeCacheCmd.Flags().Float64VarP(&memberSkewPercent, "skew-percent", "P", 20, "percent from the average size a member must differ by to be skewed")
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"testing"
+)
+
+func TestAnalyzeCacheData(t *testing.T) {
+	var (
+		g          = gomega.NewGomegaWithT(t)
+		partitions = []config.CachePartitionDetail{
+			{PartitionID: 0, MemberID: 1, Count: 10, TotalSize: 1000},
+			{PartitionID: 1, MemberID: 1, Count: 10, TotalSize: 1000},
+			{PartitionID: 2, MemberID: 1, Count: 500, TotalSize: 50000},
+			{PartitionID: 3, MemberID: 2, Count: 10, TotalSize: 1000},
+			{PartitionID: 4, MemberID: 2, Count: 10, TotalSize: 1000},
+			{PartitionID: 5, MemberID: 3, Count: 10, TotalSize: 1000},
+			{PartitionID: 6, MemberID: 3, Count: 10, TotalSize: 1000},
+		}
+		members = []config.CacheDetail{
+			{NodeID: "1", Tier: "back", UnitsBytes: 52000},
+			{NodeID: "1", Tier: "front", UnitsBytes: 100},
+			{NodeID: "2", Tier: "back", UnitsBytes: 2000},
+			{NodeID: "3", Tier: "back", UnitsBytes: 2000},
+			{NodeID: "4", Tier: "back", UnitsBytes: 0},
+		}
+	)
+
+	hotPartitionFactor = 3
+	memberSkewPercent = 20
+
+	analysis := analyzeCacheData("PartitionedCache", "test", partitions, members)
+
+	g.Expect(analysis.MedianPartitionSize).To(gomega.Equal(int64(1000)))
+	g.Expect(analysis.MedianPartitionCount).To(gomega.Equal(int32(10)))
+	g.Expect(len(analysis.HotPartitions)).To(gomega.Equal(1))
+	g.Expect(analysis.HotPartitions[0].PartitionID).To(gomega.Equal(int32(2)))
+	g.Expect(analysis.HotPartitions[0].SizeRatio).To(gomega.Equal(50.0))
+
+	// members without partitions are included
+	g.Expect(len(analysis.Members)).To(gomega.Equal(4))
+	g.Expect(analysis.Members[0].UnitsBytes).To(gomega.Equal(int64(52000)))
+	g.Expect(analysis.Members[0].Skewed).To(gomega.BeTrue())
+	g.Expect(analysis.Members[3].Partitions).To(gomega.Equal(0))
+
+	// the hot partition cannot be moved, so only the smaller partitions are moved to member 4
+	g.Expect(analysis.Rebalance.PartitionsToMove).To(gomega.Equal(2))
+	g.Expect(analysis.Rebalance.BytesToMove).To(gomega.Equal(int64(2000)))
+	g.Expect(analysis.Rebalance.EstimatedMaxSkew).To(gomega.BeNumerically("<", analysis.Rebalance.CurrentMaxSkew))
+
+	g.Expect(getMedian([]int64{5, 1, 3, 2})).To(gomega.Equal(int64(2)))
+	g.Expect(getMedian(nil)).To(gomega.Equal(int64(0)))
+}
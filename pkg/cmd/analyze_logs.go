@@ -0,0 +1,306 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	eventMemberJoined        = "MEMBER_JOINED"
+	eventMemberLeft          = "MEMBER_LEFT"
+	eventPartitionTransfer   = "PARTITION_TRANSFER"
+	eventServiceRestart      = "SERVICE_RESTART"
+	eventCommunicationDelay  = "COMMUNICATION_DELAY"
+	eventGCPause             = "GC_PAUSE"
+	eventStuckThread         = "STUCK_THREAD"
+	maxEventMessageLength    = 120
+	coherenceMessageSplitter = "): "
+)
+
+var (
+	logFilesParam   string
+	eventTypesParam string
+	summaryOnly     bool
+
+	validEventTypes = []string{eventMemberJoined, eventMemberLeft, eventPartitionTransfer, eventServiceRestart,
+		eventCommunicationDelay, eventGCPause, eventStuckThread}
+
+	// logEventPatterns are the patterns used to classify log messages into events, in order of precedence.
+	// The first sub-match, if any, is a duration in millis.
+	logEventPatterns = []struct {
+		eventType string
+		pattern   *regexp.Regexp
+	}{
+		{eventStuckThread, regexp.MustCompile(`(?i)appears to be stuck|stuck thread|guardian.*(?:timeout|not responding|terminat|recover)`)},
+		{eventCommunicationDelay, regexp.MustCompile(`(?i)experienced a (\d+) ms communication delay|failed to respond|packet timeout`)},
+		{eventGCPause, regexp.MustCompile(`(?i)pause (?:young|full|remark|cleanup|initial mark)[^\n]*?([\d.]+)ms|probable local gc`)},
+		{eventMemberJoined, regexp.MustCompile(`(?i)joined cluster|MemberJoined`)},
+		{eventMemberLeft, regexp.MustCompile(`(?i)left cluster|MemberLeft|has been removed from the cluster`)},
+		{eventServiceRestart, regexp.MustCompile(`(?i)restarting (?:service|NamedCache)|has been restarted|service \S+ (?:has been )?terminated`)},
+		{eventPartitionTransfer, regexp.MustCompile(`(?i)transferring .*partition|orphaned .*partitions|restored from backup|partition ownership has stabilized|rebalanc`)},
+	}
+)
+
+// logEvent is a structured event parsed from a Coherence log file.
+type logEvent struct {
+	Time           time.Time `json:"time"`
+	Member         string    `json:"member"`
+	Type           string    `json:"type"`
+	DurationMillis int64     `json:"durationMillis,omitempty"`
+	Message        string    `json:"message"`
+}
+
+// logEventSummary is a summary of the events of a type.
+type logEventSummary struct {
+	Type              string    `json:"type"`
+	Count             int       `json:"count"`
+	Members           []string  `json:"members"`
+	First             time.Time `json:"first"`
+	Last              time.Time `json:"last"`
+	MaxDurationMillis int64     `json:"maxDurationMillis"`
+}
+
+// logAnalysis is the result of analyzing log files.
+type logAnalysis struct {
+	Events  []logEvent        `json:"events"`
+	Summary []logEventSummary `json:"summary"`
+}
+
+// analyzeLogsCmd represents the analyze logs command.
+var analyzeLogsCmd = &cobra.Command{
+	Use:   "logs [cluster-name]",
+	Short: "analyze Coherence log files and display a timeline of events",
+	Long: `The 'analyze logs' command parses Coherence log files into events and displays a timeline and
+summary counts. Events include member joined and left, partition transfers, service restarts,
+communication delays, GC pauses and stuck threads. Specify the name of a manually created cluster
+to analyze its member logs, or use '-f' to specify a comma separated list of log files or directories
+containing '.log' files, such as logs supplied from another environment.`,
+	ValidArgsFunction: completionAllManualClusters,
+	Args:              cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			logs       []memberLog
+			eventTypes []string
+			err        error
+		)
+
+		if len(args) == 0 && logFilesParam == "" {
+			return errors.New("you must specify a cluster name or log files using -f")
+		}
+
+		if eventTypesParam != "" {
+			for _, v := range strings.Split(eventTypesParam, ",") {
+				eventType := strings.ToUpper(strings.TrimSpace(v))
+				if !utils.SliceContains(validEventTypes, eventType) {
+					return fmt.Errorf("invalid event type %s, must be one of %v", v, validEventTypes)
+				}
+				eventTypes = append(eventTypes, eventType)
+			}
+		}
+
+		filter, err := getLogFilter(time.Now())
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 1 {
+			found, connection := GetClusterConnection(args[0])
+			if !found {
+				return errors.New(UnableToFindClusterMsg + args[0])
+			}
+			if err = checkOperation(connection, "analyze logs"); err != nil {
+				return err
+			}
+			if connection.LoggingDestination != "" {
+				logsDirectory = connection.LoggingDestination
+			}
+			if logs, err = getMemberLogs(filepath.Join(logsDirectory, connection.Name), ""); err != nil {
+				return err
+			}
+		}
+
+		if logFilesParam != "" {
+			fileLogs, err := getLogFiles(logFilesParam)
+			if err != nil {
+				return err
+			}
+			logs = append(logs, fileLogs...)
+		}
+
+		events := make([]logEvent, 0)
+		for i := range logs {
+			entries, _, err := readMemberLog(&logs[i], filter)
+			if err != nil {
+				return err
+			}
+			for _, e := range parseLogEvents(logs[i].member, entries) {
+				if len(eventTypes) == 0 || utils.SliceContains(eventTypes, e.Type) {
+					events = append(events, e)
+				}
+			}
+		}
+
+		sort.SliceStable(events, func(p, q int) bool {
+			return events[p].Time.Before(events[q].Time)
+		})
+
+		analysis := logAnalysis{Events: events, Summary: summariseLogEvents(events)}
+
+		if isJSONPathOrJSON() {
+			jsonData, err := json.Marshal(analysis)
+			if err != nil {
+				return err
+			}
+			return processJSONOutput(cmd, jsonData)
+		}
+
+		cmd.Printf("Analyzed %d log files, found %d events\n", len(logs), len(events))
+		if len(events) == 0 {
+			return nil
+		}
+
+		if !summaryOnly {
+			cmd.Println()
+			cmd.Println(FormatLogEvents(events))
+		}
+		cmd.Println(FormatLogEventSummary(analysis.Summary))
+
+		return nil
+	},
+}
+
+// getLogFiles returns the log files for a comma separated list of files or directories.
+func getLogFiles(files string) ([]memberLog, error) {
+	logs := make([]memberLog, 0)
+
+	for _, v := range strings.Split(files, ",") {
+		fileName := strings.TrimSpace(v)
+		stat, err := os.Stat(fileName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find log file or directory %s", fileName)
+		}
+		if stat.IsDir() {
+			dirLogs, err := getMemberLogs(fileName, "")
+			if err != nil {
+				return nil, err
+			}
+			logs = append(logs, dirLogs...)
+			continue
+		}
+		member := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+		logs = append(logs, memberLog{member: member, fileName: fileName})
+	}
+
+	return logs, nil
+}
+
+// parseLogEvents parses the log entries for a member into events. The first line of each entry
+// is classified, and continuation lines are only checked for GC pauses from JVM logging.
+func parseLogEvents(member string, entries []logEntry) []logEvent {
+	events := make([]logEvent, 0)
+
+	for _, entry := range entries {
+		for i, line := range entry.lines {
+			eventType, duration, ok := classifyLogLine(line)
+			if !ok || (i > 0 && eventType != eventGCPause) {
+				continue
+			}
+			events = append(events, logEvent{Time: entry.timestamp, Member: member, Type: eventType,
+				DurationMillis: duration, Message: getLogMessage(line)})
+		}
+	}
+
+	return events
+}
+
+// classifyLogLine returns the event type and any duration in millis for a log line.
+func classifyLogLine(line string) (string, int64, bool) {
+	for _, p := range logEventPatterns {
+		match := p.pattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		var duration int64
+		if len(match) > 1 && match[1] != "" {
+			if value, err := strconv.ParseFloat(match[1], 64); err == nil {
+				duration = int64(value)
+			}
+		}
+		return p.eventType, duration, true
+	}
+	return "", 0, false
+}
+
+// getLogMessage returns the message from a Coherence log line, without the timestamp,
+// level and thread details, truncated to a maximum length.
+func getLogMessage(line string) string {
+	message := line
+	if _, ok := getLogLineTime(line); ok {
+		if index := strings.Index(line, coherenceMessageSplitter); index > 0 {
+			message = line[index+len(coherenceMessageSplitter):]
+		}
+	}
+	message = strings.TrimSpace(message)
+	if len(message) > maxEventMessageLength {
+		message = message[:maxEventMessageLength] + "..."
+	}
+	return message
+}
+
+// summariseLogEvents returns a summary of the events by type.
+func summariseLogEvents(events []logEvent) []logEventSummary {
+	var (
+		summaries = make(map[string]*logEventSummary)
+		result    = make([]logEventSummary, 0)
+	)
+
+	for _, e := range events {
+		summary, ok := summaries[e.Type]
+		if !ok {
+			summary = &logEventSummary{Type: e.Type, Members: make([]string, 0), First: e.Time}
+			summaries[e.Type] = summary
+		}
+		summary.Count++
+		summary.Last = e.Time
+		if !utils.SliceContains(summary.Members, e.Member) {
+			summary.Members = append(summary.Members, e.Member)
+		}
+		if e.DurationMillis > summary.MaxDurationMillis {
+			summary.MaxDurationMillis = e.DurationMillis
+		}
+	}
+
+	// return in the order of valid event types
+	for _, eventType := range validEventTypes {
+		if summary, ok := summaries[eventType]; ok {
+			sort.Strings(summary.Members)
+			result = append(result, *summary)
+		}
+	}
+
+	return result
+}
+
+func init() {
+	analyzeLogsCmd.Flags().StringVarP(&logFilesParam, "files", "f", "", "comma separated list of log files or directories to analyze")
+	analyzeLogsCmd.Flags().StringVarP(&eventTypesParam, "types", "t", "", fmt.Sprintf("comma separated list of event types to include from %v", validEventTypes))
+	analyzeLogsCmd.Flags().StringVarP(&logSinceParam, "since", "", "", "only include messages after this time or duration before now")
+	analyzeLogsCmd.Flags().StringVarP(&logUntilParam, "until", "", "", "only include messages before this time or duration before now")
+	analyzeLogsCmd.Flags().BoolVarP(&summaryOnly, "summary", "s", false, "only display the summary")
+}
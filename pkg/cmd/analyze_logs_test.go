@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testEventsLog = `2026-03-26 08:11:00.537/1.234 Oracle Coherence CE 22.06 <Info> (thread=main, member=n/a): Started cluster Name=local
+2026-03-26 08:11:01.100/1.800 Oracle Coherence CE 22.06 <Info> (thread=Cluster, member=1): Member(Id=2, Timestamp=2026-03-26 08:11:01.000) joined Cluster with senior member 1
+2026-03-26 08:11:02.200/2.900 Oracle Coherence CE 22.06 <Warning> (thread=Cluster, member=1): Experienced a 4172 ms communication delay (probable remote GC) with Member(Id=2)
+[2026-03-26T08:11:02.300+0000][gc] GC(12) Pause Full (System.gc()) 512M->128M(1024M) 1234.567ms
+2026-03-26 08:11:03.300/3.000 Oracle Coherence CE 22.06 <Warning> (thread=PartitionedCache, member=1): Transferring 128KB of backup[1] for PartitionSet{1, 2} to member 2
+2026-03-26 08:11:04.400/4.100 Oracle Coherence CE 22.06 <Error> (thread=Guardian, member=1): Thread Worker:0 appears to be stuck; attempting to recover
+2026-03-26 08:11:05.500/5.200 Oracle Coherence CE 22.06 <Info> (thread=Cluster, member=1): MemberLeft notification for Member(Id=2)
+2026-03-26 08:11:06.600/6.300 Oracle Coherence CE 22.06 <Warning> (thread=main, member=1): Restarting Service: PartitionedCache
+`
+
+func TestParseLogEvents(t *testing.T) {
+	var (
+		g   = gomega.NewGomegaWithT(t)
+		dir = t.TempDir()
+	)
+
+	g.Expect(os.WriteFile(filepath.Join(dir, "storage-0.log"), []byte(testEventsLog), 0600)).To(gomega.Succeed())
+
+	logs, err := getLogFiles(filepath.Join(dir, "storage-0.log"))
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(logs)).To(gomega.Equal(1))
+	g.Expect(logs[0].member).To(gomega.Equal("storage-0"))
+
+	_, err = getLogFiles(filepath.Join(dir, "missing.log"))
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	entries, _, err := readMemberLog(&logs[0], logFilter{level: -1})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+	events := parseLogEvents("storage-0", entries)
+	types := make([]string, 0, len(events))
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	g.Expect(types).To(gomega.Equal([]string{eventMemberJoined, eventCommunicationDelay, eventGCPause,
+		eventPartitionTransfer, eventStuckThread, eventMemberLeft, eventServiceRestart}))
+
+	g.Expect(events[1].DurationMillis).To(gomega.Equal(int64(4172)))
+	g.Expect(events[2].DurationMillis).To(gomega.Equal(int64(1234)))
+	g.Expect(events[4].Message).To(gomega.Equal("Thread Worker:0 appears to be stuck; attempting to recover"))
+
+	// the guardian keywords are not treated as a duration
+	eventType, duration, ok := classifyLogLine("Guardian timeout for thread Worker:1")
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(eventType).To(gomega.Equal(eventStuckThread))
+	g.Expect(duration).To(gomega.Equal(int64(0)))
+
+	summary := summariseLogEvents(append(events, logEvent{Member: "storage-1", Type: eventMemberJoined}))
+	g.Expect(len(summary)).To(gomega.Equal(7))
+	g.Expect(summary[0].Type).To(gomega.Equal(eventMemberJoined))
+	g.Expect(summary[0].Count).To(gomega.Equal(2))
+	g.Expect(summary[0].Members).To(gomega.Equal([]string{"storage-0", "storage-1"}))
+}
@@ -0,0 +1,348 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"sort"
+	"strconv"
+)
+
+const (
+	levelMember  = "MEMBER"
+	levelMachine = "MACHINE"
+	levelRack    = "RACK"
+	levelSite    = "SITE"
+	orphanedID   = -1
+)
+
+var (
+	skipHeatmap   bool
+	heatmapWidth  int32
+	skewLevels    = []string{levelMember, levelMachine, levelRack, levelSite}
+	statusHANames = map[string]string{levelMember: "NODE-SAFE", levelMachine: "MACHINE-SAFE", levelRack: "RACK-SAFE", levelSite: "SITE-SAFE"}
+)
+
+// partitionLocation is the member, machine, rack and site a partition is owned by.
+type partitionLocation struct {
+	MemberID int
+	Machine  string
+	Rack     string
+	Site     string
+}
+
+// partitionSkew is the primary and backup partition skew for a member, machine, rack or site.
+type partitionSkew struct {
+	Level       string  `json:"level"`
+	Name        string  `json:"name"`
+	Members     int     `json:"members"`
+	Primaries   int     `json:"primaries"`
+	Backups     int     `json:"backups"`
+	PrimarySkew float64 `json:"primarySkew"`
+	BackupSkew  float64 `json:"backupSkew"`
+}
+
+// partitionAnalysis is the result of analyzing the partition distribution for a service.
+type partitionAnalysis struct {
+	ServiceName        string               `json:"serviceName"`
+	PartitionCount     int                  `json:"partitionCount"`
+	BackupCount        int                  `json:"backupCount"`
+	StatusHA           string               `json:"statusHA"`
+	SafetyCounts       map[string]int       `json:"safetyCounts"`
+	Skew               []partitionSkew      `json:"skew"`
+	MachineUnsafe      []int                `json:"machineUnsafePartitions"`
+	partitionOwners    map[int]int          // primary owner of each partition
+	partitionSafety    map[int]string       // safety of each partition
+	memberPartitionMap map[int]map[int]bool // primary partitions owned by each member
+}
+
+// analyzePartitionsCmd represents the analyze partitions command.
+var analyzePartitionsCmd = &cobra.Command{
+	Use:   "partitions service-name",
+	Short: "analyze the partition distribution and safety for a service",
+	Long: `The 'analyze partitions' command analyzes the partition ownership for a distributed service and
+displays the primary and backup partition skew for each member, machine, rack and site, the safety
+of each partition, and a heatmap of primary partition ownership. Skew is the percentage difference
+from the average for each level. Partitions whose backups are all on the same machine as the
+primary are displayed as they would be lost if the machine failed.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, provideService)
+		}
+		return nil
+	},
+	ValidArgsFunction: completionDistributedService,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var serviceName = args[0]
+
+		connection, dataFetcher, err := GetConnectionAndDataFetcher()
+		if err != nil {
+			return err
+		}
+
+		if heatmapWidth < 10 {
+			return fmt.Errorf("heatmap width must be 10 or more")
+		}
+
+		ownership, err := getServiceOwnership(dataFetcher, serviceName)
+		if err != nil {
+			return err
+		}
+
+		membersResult, err := dataFetcher.GetMemberDetailsJSON(false)
+		if err != nil {
+			return err
+		}
+
+		members := config.Members{}
+		if err = json.Unmarshal(membersResult, &members); err != nil {
+			return utils.GetError(unableToDecode, err)
+		}
+
+		analysis := analyzePartitionOwnership(serviceName, ownership, members.Members)
+
+		if isJSONPathOrJSON() {
+			jsonData, err := json.Marshal(analysis)
+			if err != nil {
+				return err
+			}
+			return processJSONOutput(cmd, jsonData)
+		}
+
+		cmd.Println(FormatCurrentCluster(connection))
+		cmd.Printf("Service: %s, Partitions: %d, Backup Count: %d, Status HA: %s\n\n", serviceName,
+			analysis.PartitionCount, analysis.BackupCount, analysis.StatusHA)
+		cmd.Println(FormatPartitionSkew(analysis.Skew))
+		cmd.Println(FormatPartitionSafety(analysis.SafetyCounts))
+
+		if len(analysis.MachineUnsafe) > 0 {
+			cmd.Printf("Partitions with all backups on the same machine as the primary: %s\n\n",
+				utils.FormatPartitions(analysis.MachineUnsafe))
+		}
+
+		if !skipHeatmap {
+			cmd.Println(FormatPartitionHeatmap(analysis, int(heatmapWidth)))
+		}
+
+		return nil
+	},
+}
+
+// getServiceOwnership returns the partition ownership for a distributed service.
+func getServiceOwnership(dataFetcher fetcher.Fetcher, serviceName string) (map[int]*config.PartitionOwnership, error) {
+	var (
+		membersDetails = config.ServiceMemberDetails{}
+		memberNodeID   string
+		ownership      config.Ownership
+	)
+
+	servicesResult, err := GetDistributedServices(dataFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.SliceContains(servicesResult, serviceName) {
+		return nil, fmt.Errorf(unableToFindService, serviceName)
+	}
+
+	membersResult, err := dataFetcher.GetServiceMembersDetailsJSON(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(membersResult, &membersDetails); err != nil {
+		return nil, utils.GetError(serviceUnmarshall, err)
+	}
+
+	if len(membersDetails.Services) > 0 {
+		memberNodeID = membersDetails.Services[0].NodeID
+	}
+
+	if memberNodeID == "" {
+		return nil, fmt.Errorf("cannot find a node for service %s", serviceName)
+	}
+
+	ownershipData, err := dataFetcher.GetServiceOwnershipJSON(serviceName, memberNodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ownershipData) != 0 {
+		if err = json.Unmarshal(ownershipData, &ownership); err != nil {
+			return nil, utils.GetError("unable to decode ownership", err)
+		}
+	}
+
+	return utils.ParsePartitionOwnership(ownership.Details)
+}
+
+// analyzePartitionOwnership analyzes the skew and safety of the partition ownership for a service.
+func analyzePartitionOwnership(serviceName string, ownership map[int]*config.PartitionOwnership, members []config.Member) partitionAnalysis {
+	var (
+		backupCount = utils.GetBackupCount(ownership)
+		locations   = make(map[int]partitionLocation)
+		backups     = make(map[int][]int)
+		analysis    = partitionAnalysis{ServiceName: serviceName, BackupCount: backupCount,
+			SafetyCounts: make(map[string]int), Skew: make([]partitionSkew, 0), MachineUnsafe: make([]int, 0),
+			partitionOwners: make(map[int]int), partitionSafety: make(map[int]string),
+			memberPartitionMap: make(map[int]map[int]bool)}
+	)
+
+	for memberID, value := range ownership {
+		machine, rack, site := getMachineRackSite(fmt.Sprintf("%d", memberID), members)
+		locations[memberID] = partitionLocation{MemberID: memberID, Machine: machine, Rack: rack, Site: site}
+		analysis.memberPartitionMap[memberID] = make(map[int]bool)
+
+		for i := 0; i <= backupCount; i++ {
+			for _, partition := range value.PartitionMap[i] {
+				if i == 0 {
+					analysis.partitionOwners[partition] = memberID
+					analysis.memberPartitionMap[memberID][partition] = true
+				} else {
+					backups[partition] = append(backups[partition], memberID)
+				}
+			}
+		}
+	}
+
+	analysis.PartitionCount = len(analysis.partitionOwners)
+
+	// determine the safety of each partition as the safest of its backups
+	for partition, owner := range analysis.partitionOwners {
+		safety := allStatusHA[0]
+		if owner != orphanedID {
+			for _, backup := range backups[partition] {
+				if backupSafety := getBackupSafety(locations[owner], locations[backup]); isStatusHASaferThan(backupSafety, safety) {
+					safety = backupSafety
+				}
+			}
+		}
+		analysis.partitionSafety[partition] = safety
+		analysis.SafetyCounts[safety]++
+
+		if backupCount > 0 && !isStatusHASaferThan(safety, statusHANames[levelMachine]) {
+			analysis.MachineUnsafe = append(analysis.MachineUnsafe, partition)
+		}
+	}
+	sort.Ints(analysis.MachineUnsafe)
+
+	// the service status HA is the least safe partition
+	analysis.StatusHA = allStatusHA[len(allStatusHA)-1]
+	for safety := range analysis.SafetyCounts {
+		if !isStatusHASaferThan(safety, analysis.StatusHA) {
+			analysis.StatusHA = safety
+		}
+	}
+	if analysis.PartitionCount == 0 {
+		analysis.StatusHA = allStatusHA[0]
+	}
+
+	for _, level := range skewLevels {
+		analysis.Skew = append(analysis.Skew, getPartitionSkew(level, ownership, locations)...)
+	}
+
+	return analysis
+}
+
+// getBackupSafety returns the status HA value provided by a backup for a primary.
+func getBackupSafety(primary, backup partitionLocation) string {
+	switch {
+	case primary.MemberID == backup.MemberID || backup.MemberID == orphanedID:
+		return allStatusHA[0]
+	case primary.Site != backup.Site:
+		return statusHANames[levelSite]
+	case primary.Rack != backup.Rack:
+		return statusHANames[levelRack]
+	case primary.Machine != backup.Machine:
+		return statusHANames[levelMachine]
+	default:
+		return statusHANames[levelMember]
+	}
+}
+
+// getPartitionSkew returns the primary and backup skew for the members, machines, racks or sites.
+// Nothing is returned for a level if there is only one value for the level or the value is not set.
+func getPartitionSkew(level string, ownership map[int]*config.PartitionOwnership, locations map[int]partitionLocation) []partitionSkew {
+	var (
+		skews          = make(map[string]*partitionSkew)
+		result         = make([]partitionSkew, 0)
+		totalPrimaries int
+		totalBackups   int
+	)
+
+	for memberID, value := range ownership {
+		if memberID == orphanedID {
+			continue
+		}
+		location := locations[memberID]
+		name := fmt.Sprintf("%d", memberID)
+		switch level {
+		case levelMachine:
+			name = location.Machine
+		case levelRack:
+			name = location.Rack
+		case levelSite:
+			name = location.Site
+		}
+		if name == "" {
+			return result
+		}
+
+		skew, ok := skews[name]
+		if !ok {
+			skew = &partitionSkew{Level: level, Name: name}
+			skews[name] = skew
+		}
+		skew.Members++
+		skew.Primaries += value.PrimaryPartitions
+		skew.Backups += value.BackupPartitions
+		totalPrimaries += value.PrimaryPartitions
+		totalBackups += value.BackupPartitions
+	}
+
+	if len(skews) < 2 && level != levelMember {
+		return result
+	}
+
+	averagePrimaries := float64(totalPrimaries) / float64(len(skews))
+	averageBackups := float64(totalBackups) / float64(len(skews))
+
+	for _, skew := range skews {
+		skew.PrimarySkew = getSkewPercent(skew.Primaries, averagePrimaries)
+		skew.BackupSkew = getSkewPercent(skew.Backups, averageBackups)
+		result = append(result, *skew)
+	}
+
+	sort.Slice(result, func(p, q int) bool {
+		if level == levelMember {
+			memberID1, _ := strconv.Atoi(result[p].Name)
+			memberID2, _ := strconv.Atoi(result[q].Name)
+			return memberID1 < memberID2
+		}
+		return result[p].Name < result[q].Name
+	})
+
+	return result
+}
+
+// getSkewPercent returns the percentage difference of a value from the average.
+func getSkewPercent(value int, average float64) float64 {
+	if average == 0 {
+		return 0
+	}
+	return (float64(value) - average) / average * 100
+}
+
+func init() {
+	analyzePartitionsCmd.Flags().BoolVarP(&skipHeatmap, "skip-heatmap", "H", false, "do not display the heatmap")
+	analyzePartitionsCmd.Flags().Int32VarP(&heatmapWidth, "width", "", 64, "maximum width of the heatmap")
+}
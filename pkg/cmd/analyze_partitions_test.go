@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzePartitionOwnership(t *testing.T) {
+	var (
+		g       = gomega.NewGomegaWithT(t)
+		members = []config.Member{
+			{NodeID: "1", MachineName: "m1", RackName: "r1", SiteName: "s1"},
+			{NodeID: "2", MachineName: "m1", RackName: "r1", SiteName: "s1"},
+			{NodeID: "3", MachineName: "m2", RackName: "r1", SiteName: "s1"},
+		}
+		ownership = map[int]*config.PartitionOwnership{
+			1: {MemberID: 1, PrimaryPartitions: 4, BackupPartitions: 1,
+				PartitionMap: map[int][]int{0: {0, 1, 2, 3}, 1: {4}}},
+			2: {MemberID: 2, PrimaryPartitions: 1, BackupPartitions: 2,
+				PartitionMap: map[int][]int{0: {4}, 1: {0, 1}}},
+			3: {MemberID: 3, PrimaryPartitions: 1, BackupPartitions: 2,
+				PartitionMap: map[int][]int{0: {5}, 1: {2, 3}}},
+		}
+	)
+
+	analysis := analyzePartitionOwnership("PartitionedCache", ownership, members)
+
+	g.Expect(analysis.PartitionCount).To(gomega.Equal(6))
+	g.Expect(analysis.BackupCount).To(gomega.Equal(1))
+
+	// partition 5 has no backup, 0, 1 and 4 have backups on the same machine
+	g.Expect(analysis.StatusHA).To(gomega.Equal("ENDANGERED"))
+	g.Expect(analysis.SafetyCounts).To(gomega.Equal(map[string]int{"ENDANGERED": 1, "NODE-SAFE": 3, "MACHINE-SAFE": 2}))
+	g.Expect(analysis.MachineUnsafe).To(gomega.Equal([]int{0, 1, 4, 5}))
+
+	// members and machines are skewed, there is only one rack and site
+	g.Expect(len(analysis.Skew)).To(gomega.Equal(5))
+	g.Expect(analysis.Skew[0].Name).To(gomega.Equal("1"))
+	g.Expect(analysis.Skew[0].PrimarySkew).To(gomega.Equal(100.0))
+	g.Expect(analysis.Skew[3].Level).To(gomega.Equal(levelMachine))
+	g.Expect(analysis.Skew[3].Members).To(gomega.Equal(2))
+	g.Expect(analysis.Skew[3].Primaries).To(gomega.Equal(5))
+
+	heatmap := FormatPartitionHeatmap(analysis, 10)
+	g.Expect(heatmap).To(gomega.ContainSubstring("1        |████  |"))
+	g.Expect(heatmap).To(gomega.ContainSubstring("Unsafe   |!!  !X|"))
+	g.Expect(len(strings.Split(strings.TrimSpace(heatmap), "\n"))).To(gomega.Equal(6))
+}
@@ -0,0 +1,454 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	threadStateRunnable     = "RUNNABLE"
+	threadStateBlocked      = "BLOCKED"
+	threadStateWaiting      = "WAITING"
+	threadStateTimedWaiting = "TIMED_WAITING"
+	threadStateUnknown      = "UNKNOWN"
+	maxSignatureFrames      = 3
+	maxDisplayedGroups      = 10
+)
+
+var (
+	analyzeThreadDumps bool
+
+	threadHeaderRegex     = regexp.MustCompile(`^"(.+?)"(.*)$`)
+	threadStateRegex      = regexp.MustCompile(`(?:State:|java\.lang\.Thread\.State:)\s*([A-Z_]+)`)
+	threadWaitingOnRegex  = regexp.MustCompile(`(?:waiting to lock|blocked on|waiting on|parking to wait for)\s+<?(0x[0-9a-fA-F]+|[\w.$]+@[0-9a-fA-F]+)>?`)
+	threadOwnedByRegex    = regexp.MustCompile(`owned by "(.+?)"`)
+	threadLockedRegex     = regexp.MustCompile(`(?:- locked|^-)\s+<?(0x[0-9a-fA-F]+|[\w.$]+@[0-9a-fA-F]+)>?`)
+	threadDumpFileRegex   = regexp.MustCompile(`^thread-dump-node-(\d+)-(\d+)\.log$`)
+	coherenceThreadRegex  = regexp.MustCompile(`(?i)(worker|^DistributedCache|^PartitionedCache|^ReplicatedCache|^OptimisticCache|^Proxy|^Invocation|^Cluster|^Packet|^Transport|^PagedTopic|^FederatedCache)`)
+	idleThreadFramesRegex = regexp.MustCompile(`(?i)(\.wait\d*\(|\.park|\.sleep\d*\(|epoll|\.poll\d*\(|\.select\d*\(|accept\d*\(|socketRead|\.read0\(|ConcurrentNotifier\.await)`)
+)
+
+// threadInfo contains the details of a thread from a thread dump.
+type threadInfo struct {
+	Name      string
+	State     string
+	Frames    []string
+	WaitingOn string
+	LockOwner string
+	Locked    []string
+}
+
+// threadGroup is a group of threads with the same state and stack signature across dumps.
+type threadGroup struct {
+	State     string   `json:"state"`
+	Signature []string `json:"signature"`
+	Threads   []string `json:"threads"`
+	Samples   int      `json:"samples"`
+}
+
+// memberThreadAnalysis is the analysis of the thread dumps for a member.
+type memberThreadAnalysis struct {
+	NodeID             string         `json:"nodeId"`
+	Dumps              int            `json:"dumps"`
+	Threads            int            `json:"threads"`
+	States             map[string]int `json:"states"`
+	Groups             []threadGroup  `json:"groups"`
+	BlockedThreads     []string       `json:"blockedThreads"`
+	BusyServiceThreads []string       `json:"busyServiceThreads"`
+	Deadlocks          []string       `json:"deadlocks"`
+}
+
+// threadDumpSummary is a cluster-wide summary of the thread dump analysis.
+type threadDumpSummary struct {
+	Members            int `json:"members"`
+	Dumps              int `json:"dumps"`
+	Threads            int `json:"threads"`
+	BlockedThreads     int `json:"blockedThreads"`
+	BusyServiceThreads int `json:"busyServiceThreads"`
+	Deadlocks          int `json:"deadlocks"`
+}
+
+// threadDumpAnalysis is the result of analyzing thread dumps for one or more members.
+type threadDumpAnalysis struct {
+	Members []memberThreadAnalysis `json:"members"`
+	Summary threadDumpSummary      `json:"summary"`
+}
+
+// analyzeThreadDumpsCmd represents the analyze thread-dumps command.
+var analyzeThreadDumpsCmd = &cobra.Command{
+	Use:   "thread-dumps directory",
+	Short: "analyze thread dumps previously retrieved using 'retrieve thread-dumps'",
+	Long: `The 'analyze thread-dumps' command analyzes thread dumps in a directory that were previously
+retrieved using 'retrieve thread-dumps'. Threads are grouped by state and stack signature, and threads
+that are blocked in all dumps, Coherence service or worker threads that are busy in all dumps and
+deadlocks are displayed for each member, along with a cluster-wide summary.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, "you must provide a directory containing thread dumps")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		files, err := getThreadDumpFiles(args[0])
+		if err != nil {
+			return err
+		}
+
+		analysis, err := analyzeThreadDumpFiles(files)
+		if err != nil {
+			return err
+		}
+
+		return displayThreadDumpAnalysis(cmd, analysis)
+	},
+}
+
+// getThreadDumpFiles returns the thread dump files in a directory keyed by node id, in iteration order.
+func getThreadDumpFiles(directory string) (map[string][]string, error) {
+	var (
+		files      = make(map[string][]string)
+		iterations = make(map[string]int)
+	)
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, utils.GetError("unable to read directory "+directory, err)
+	}
+
+	for _, entry := range entries {
+		match := threadDumpFileRegex.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		fileName := filepath.Join(directory, entry.Name())
+		iterations[fileName], _ = strconv.Atoi(match[2])
+		files[match[1]] = append(files[match[1]], fileName)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("unable to find any thread dumps in %s", directory)
+	}
+
+	for _, value := range files {
+		sort.Slice(value, func(p, q int) bool {
+			return iterations[value[p]] < iterations[value[q]]
+		})
+	}
+
+	return files, nil
+}
+
+// analyzeRetrievedThreadDumps analyzes the thread dumps just retrieved for the nodes.
+func analyzeRetrievedThreadDumps(cmd *cobra.Command, nodeIDs []string) error {
+	var (
+		files = make(map[string][]string)
+		i     int32
+	)
+
+	for _, nodeID := range nodeIDs {
+		for i = 1; i <= numThreadDumps; i++ {
+			files[nodeID] = append(files[nodeID], filepath.Join(outputDirectory, GetFileName(nodeID, i)))
+		}
+	}
+
+	analysis, err := analyzeThreadDumpFiles(files)
+	if err != nil {
+		return err
+	}
+
+	return displayThreadDumpAnalysis(cmd, analysis)
+}
+
+// analyzeThreadDumpFiles analyzes the thread dump files for each node.
+func analyzeThreadDumpFiles(files map[string][]string) (threadDumpAnalysis, error) {
+	var analysis = threadDumpAnalysis{Members: make([]memberThreadAnalysis, 0, len(files))}
+
+	for nodeID, fileNames := range files {
+		dumps := make([][]threadInfo, 0, len(fileNames))
+		for _, fileName := range fileNames {
+			data, err := os.ReadFile(fileName)
+			if err != nil {
+				return analysis, utils.GetError("unable to read thread dump "+fileName, err)
+			}
+			dumps = append(dumps, parseThreadDump(string(data)))
+		}
+
+		member := analyzeMemberThreadDumps(nodeID, dumps)
+		analysis.Members = append(analysis.Members, member)
+
+		analysis.Summary.Members++
+		analysis.Summary.Dumps += member.Dumps
+		analysis.Summary.Threads += member.Threads
+		analysis.Summary.BlockedThreads += len(member.BlockedThreads)
+		analysis.Summary.BusyServiceThreads += len(member.BusyServiceThreads)
+		analysis.Summary.Deadlocks += len(member.Deadlocks)
+	}
+
+	sort.Slice(analysis.Members, func(p, q int) bool {
+		nodeID1, _ := strconv.Atoi(analysis.Members[p].NodeID)
+		nodeID2, _ := strconv.Atoi(analysis.Members[q].NodeID)
+		return nodeID1 < nodeID2
+	})
+
+	return analysis, nil
+}
+
+// parseThreadDump parses a thread dump in either the Coherence or jstack format.
+func parseThreadDump(content string) []threadInfo {
+	var (
+		threads = make([]threadInfo, 0)
+		current *threadInfo
+	)
+
+	for _, rawLine := range strings.Split(content, "\n") {
+		line := strings.TrimSpace(rawLine)
+
+		if match := threadHeaderRegex.FindStringSubmatch(line); match != nil {
+			threads = append(threads, threadInfo{Name: match[1], State: threadStateUnknown})
+			current = &threads[len(threads)-1]
+			if state := threadStateRegex.FindStringSubmatch(match[2]); state != nil {
+				current.State = state[1]
+			}
+			continue
+		}
+
+		if current == nil || line == "" {
+			continue
+		}
+
+		if state := threadStateRegex.FindStringSubmatch(line); state != nil {
+			current.State = state[1]
+		} else if strings.HasPrefix(line, "at ") {
+			current.Frames = append(current.Frames, strings.TrimPrefix(line, "at "))
+		} else if match := threadWaitingOnRegex.FindStringSubmatch(line); match != nil {
+			if current.WaitingOn == "" {
+				current.WaitingOn = match[1]
+			}
+			if owner := threadOwnedByRegex.FindStringSubmatch(line); owner != nil {
+				current.LockOwner = owner[1]
+			}
+		} else if match := threadLockedRegex.FindStringSubmatch(line); match != nil {
+			current.Locked = append(current.Locked, match[1])
+		}
+	}
+
+	return threads
+}
+
+// analyzeMemberThreadDumps analyzes the consecutive thread dumps for a member.
+func analyzeMemberThreadDumps(nodeID string, dumps [][]threadInfo) memberThreadAnalysis {
+	var (
+		analysis = memberThreadAnalysis{NodeID: nodeID, Dumps: len(dumps), States: make(map[string]int),
+			Groups: make([]threadGroup, 0), BlockedThreads: make([]string, 0),
+			BusyServiceThreads: make([]string, 0), Deadlocks: make([]string, 0)}
+		groups    = make(map[string]*threadGroup)
+		blocked   = make(map[string]int)
+		busy      = make(map[string]int)
+		deadlocks = make(map[string]bool)
+	)
+
+	for i, dump := range dumps {
+		for _, thread := range dump {
+			signature := thread.Frames[:min(len(thread.Frames), maxSignatureFrames)]
+			key := thread.State + "|" + strings.Join(signature, "|")
+			group, ok := groups[key]
+			if !ok {
+				group = &threadGroup{State: thread.State, Signature: signature, Threads: make([]string, 0)}
+				groups[key] = group
+			}
+			group.Samples++
+			if !utils.SliceContains(group.Threads, thread.Name) {
+				group.Threads = append(group.Threads, thread.Name)
+			}
+
+			if thread.State == threadStateBlocked {
+				blocked[thread.Name]++
+			}
+			if isBusyServiceThread(thread) {
+				busy[thread.Name]++
+			}
+
+			// the states are for the last dump
+			if i == len(dumps)-1 {
+				analysis.Threads++
+				analysis.States[thread.State]++
+			}
+		}
+
+		for _, deadlock := range findDeadlocks(dump) {
+			deadlocks[deadlock] = true
+		}
+	}
+
+	for _, group := range groups {
+		sort.Strings(group.Threads)
+		analysis.Groups = append(analysis.Groups, *group)
+	}
+	sort.Slice(analysis.Groups, func(p, q int) bool {
+		if analysis.Groups[p].Samples != analysis.Groups[q].Samples {
+			return analysis.Groups[p].Samples > analysis.Groups[q].Samples
+		}
+		return analysis.Groups[p].State < analysis.Groups[q].State
+	})
+
+	// threads must be in all dumps to be flagged, which requires more than one dump
+	if len(dumps) > 1 {
+		analysis.BlockedThreads = getThreadsInAllDumps(blocked, len(dumps))
+		analysis.BusyServiceThreads = getThreadsInAllDumps(busy, len(dumps))
+	}
+
+	for deadlock := range deadlocks {
+		analysis.Deadlocks = append(analysis.Deadlocks, deadlock)
+	}
+	sort.Strings(analysis.Deadlocks)
+
+	return analysis
+}
+
+// getThreadsInAllDumps returns the sorted thread names that were seen in all dumps.
+func getThreadsInAllDumps(counts map[string]int, dumps int) []string {
+	threads := make([]string, 0)
+	for name, count := range counts {
+		if count == dumps {
+			threads = append(threads, name)
+		}
+	}
+	sort.Strings(threads)
+	return threads
+}
+
+// isBusyServiceThread returns true if the thread is a Coherence service or worker
+// thread that is running and not idle waiting for work.
+func isBusyServiceThread(thread threadInfo) bool {
+	if thread.State != threadStateRunnable || !coherenceThreadRegex.MatchString(thread.Name) {
+		return false
+	}
+	return len(thread.Frames) > 0 && !idleThreadFramesRegex.MatchString(thread.Frames[0])
+}
+
+// findDeadlocks returns the deadlocks in a thread dump, each as a list of threads
+// waiting on each other, starting with the lowest thread name.
+func findDeadlocks(threads []threadInfo) []string {
+	var (
+		lockOwners = make(map[string]string)
+		waitsFor   = make(map[string]string)
+		deadlocks  = make([]string, 0)
+		found      = make(map[string]bool)
+	)
+
+	for _, thread := range threads {
+		for _, lock := range thread.Locked {
+			lockOwners[lock] = thread.Name
+		}
+	}
+
+	for _, thread := range threads {
+		if thread.WaitingOn == "" || thread.State == threadStateRunnable {
+			continue
+		}
+		owner := thread.LockOwner
+		if owner == "" {
+			owner = lockOwners[thread.WaitingOn]
+		}
+		if owner != "" && owner != thread.Name {
+			waitsFor[thread.Name] = owner
+		}
+	}
+
+	for start := range waitsFor {
+		var (
+			path    = []string{start}
+			visited = map[string]int{start: 0}
+			current = start
+		)
+		for {
+			next, ok := waitsFor[current]
+			if !ok {
+				break
+			}
+			if index, seen := visited[next]; seen {
+				cycle := path[index:]
+				if len(cycle) > 1 && !found[getCycleKey(cycle)] {
+					found[getCycleKey(cycle)] = true
+					deadlocks = append(deadlocks, strings.Join(rotateCycle(cycle), " -> "))
+				}
+				break
+			}
+			visited[next] = len(path)
+			path = append(path, next)
+			current = next
+		}
+	}
+
+	sort.Strings(deadlocks)
+	return deadlocks
+}
+
+// getCycleKey returns a key for a cycle that is the same regardless of the starting thread.
+func getCycleKey(cycle []string) string {
+	return strings.Join(rotateCycle(cycle), "|")
+}
+
+// rotateCycle returns the cycle starting with the lowest thread name.
+func rotateCycle(cycle []string) []string {
+	lowest := 0
+	for i, name := range cycle {
+		if name < cycle[lowest] {
+			lowest = i
+		}
+	}
+	return append(append([]string{}, cycle[lowest:]...), cycle[:lowest]...)
+}
+
+// displayThreadDumpAnalysis displays the thread dump analysis for each member and a cluster-wide summary.
+func displayThreadDumpAnalysis(cmd *cobra.Command, analysis threadDumpAnalysis) error {
+	if isJSONPathOrJSON() {
+		jsonData, err := json.Marshal(analysis)
+		if err != nil {
+			return err
+		}
+		return processJSONOutput(cmd, jsonData)
+	}
+
+	for _, member := range analysis.Members {
+		cmd.Printf("\nNode Id %s: %d dumps, %d threads\n\n", member.NodeID, member.Dumps, member.Threads)
+		cmd.Print(FormatThreadGroups(member.Groups))
+		printThreadList(cmd, "Threads blocked in all dumps", member.BlockedThreads)
+		printThreadList(cmd, "Coherence threads busy in all dumps", member.BusyServiceThreads)
+		printThreadList(cmd, "Deadlocks", member.Deadlocks)
+	}
+
+	cmd.Printf("\nSummary: %d members, %d threads blocked in all dumps, %d busy Coherence threads, %d deadlocks\n\n",
+		analysis.Summary.Members, analysis.Summary.BlockedThreads, analysis.Summary.BusyServiceThreads, analysis.Summary.Deadlocks)
+	cmd.Println(FormatThreadDumpAnalysis(analysis.Members))
+
+	return nil
+}
+
+func printThreadList(cmd *cobra.Command, title string, threads []string) {
+	if len(threads) == 0 {
+		return
+	}
+	cmd.Printf("\n%s:\n", title)
+	for _, value := range threads {
+		cmd.Printf("  %s\n", value)
+	}
+}
+
+func init() {
+	retrieveThreadDumpsCmd.Flags().BoolVarP(&analyzeThreadDumps, "analyze", "A", false, "analyze the thread dumps after they are retrieved")
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testThreadDump = `Full Thread Dump
+
+"main" id=1 State:WAITING
+    at java.lang.Object.wait(Native Method)
+    at com.tangosol.net.DefaultCacheServer.monitorServices(DefaultCacheServer.java:400)
+
+"PartitionedCacheWorker:0x0000:1" id=40 State:RUNNABLE
+    at com.example.Processor.process(Processor.java:25)
+    at com.tangosol.util.processor.AbstractProcessor.processAll(AbstractProcessor.java:40)
+
+"PartitionedCacheWorker:0x0000:2" id=41 State:RUNNABLE
+    at sun.misc.Unsafe.park(Native Method)
+    at com.oracle.coherence.common.base.ConcurrentNotifier.await(ConcurrentNotifier.java:100)
+
+"Thread-1" id=50 State:BLOCKED
+    - waiting on <0x1111> (a java.lang.Object) owned by "Thread-2" id=51
+    at com.example.Deadlock.first(Deadlock.java:10)
+
+"Thread-2" id=51 State:BLOCKED
+    - waiting on <0x2222> (a java.lang.Object) owned by "Thread-1" id=50
+    at com.example.Deadlock.second(Deadlock.java:20)
+`
+
+const testJStackDump = `"Thread-3" #52 prio=5 os_prio=0 tid=0x01 nid=0x02 waiting for monitor entry
+   java.lang.Thread.State: BLOCKED (on object monitor)
+	at com.example.Deadlock.third(Deadlock.java:30)
+	- waiting to lock <0x000000076ab1> (a java.lang.Object)
+	- locked <0x000000076ab2> (a java.lang.Object)
+
+"Thread-4" #53 prio=5 os_prio=0 tid=0x03 nid=0x04 waiting for monitor entry
+   java.lang.Thread.State: BLOCKED (on object monitor)
+	at com.example.Deadlock.fourth(Deadlock.java:40)
+	- waiting to lock <0x000000076ab2> (a java.lang.Object)
+	- locked <0x000000076ab1> (a java.lang.Object)
+`
+
+func TestAnalyzeThreadDumps(t *testing.T) {
+	var (
+		g   = gomega.NewGomegaWithT(t)
+		dir = t.TempDir()
+	)
+
+	threads := parseThreadDump(testJStackDump)
+	g.Expect(len(threads)).To(gomega.Equal(2))
+	g.Expect(threads[0].State).To(gomega.Equal(threadStateBlocked))
+	g.Expect(threads[0].WaitingOn).To(gomega.Equal("0x000000076ab1"))
+	g.Expect(threads[0].Locked).To(gomega.Equal([]string{"0x000000076ab2"}))
+	g.Expect(findDeadlocks(threads)).To(gomega.Equal([]string{"Thread-3 -> Thread-4"}))
+
+	for _, fileName := range []string{GetFileName("1", 1), GetFileName("1", 2), GetFileName("2", 1)} {
+		g.Expect(os.WriteFile(filepath.Join(dir, fileName), []byte(testThreadDump), 0600)).To(gomega.Succeed())
+	}
+
+	files, err := getThreadDumpFiles(dir)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(files)).To(gomega.Equal(2))
+	g.Expect(len(files["1"])).To(gomega.Equal(2))
+
+	analysis, err := analyzeThreadDumpFiles(files)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(analysis.Members)).To(gomega.Equal(2))
+
+	member := analysis.Members[0]
+	g.Expect(member.NodeID).To(gomega.Equal("1"))
+	g.Expect(member.Threads).To(gomega.Equal(5))
+	g.Expect(member.States[threadStateBlocked]).To(gomega.Equal(2))
+	g.Expect(member.BlockedThreads).To(gomega.Equal([]string{"Thread-1", "Thread-2"}))
+	g.Expect(member.BusyServiceThreads).To(gomega.Equal([]string{"PartitionedCacheWorker:0x0000:1"}))
+	g.Expect(member.Deadlocks).To(gomega.Equal([]string{"Thread-1 -> Thread-2"}))
+	g.Expect(member.Groups[0].Samples).To(gomega.Equal(2))
+
+	// a single dump cannot flag threads as blocked or busy in all dumps
+	g.Expect(len(analysis.Members[1].BlockedThreads)).To(gomega.Equal(0))
+	g.Expect(analysis.Summary.Deadlocks).To(gomega.Equal(2))
+	g.Expect(analysis.Summary.BlockedThreads).To(gomega.Equal(2))
+
+	_, err = getThreadDumpFiles(t.TempDir())
+	g.Expect(err).To(gomega.HaveOccurred())
+}
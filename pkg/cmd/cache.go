@@ -57,7 +57,8 @@ var getCachesCmd = &cobra.Command{
 	Long: `The 'get caches' command displays caches for a cluster. If no service
 name is specified then all services are queried. You can specify '-o wide' to
 display addition information. Use '-I' to ignore internal caches such as those
-used by Federation.`,
+used by Federation. Specify a comma separated list of connections, or 'all', using
+'--clusters' to display the caches of multiple clusters.`,
 	Args: cobra.ExactArgs(0),
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		var (
@@ -66,6 +67,10 @@ used by Federation.`,
 			dataFetcher fetcher.Fetcher
 		)
 
+		if clusterConnections != "" {
+			return runMultiClusterCommand(cmd, retrieveClusterCaches, FormatMultiClusterCaches, false)
+		}
+
 		connection, dataFetcher, err = GetConnectionAndDataFetcher()
 		if err != nil {
 			return err
@@ -106,15 +111,19 @@ used by Federation.`,
 					serviceList[0] = serviceName
 				}
 
-				value, err := formatCachesSummary(serviceList, dataFetcher)
+				cachesSummary, err := getCachesSummary(serviceList, dataFetcher)
 				if err != nil {
 					return err
 				}
 
+				if err = recordWatchSample(cachesSummary); err != nil {
+					return err
+				}
+
 				printWatchHeader(cmd)
 				cmd.Println(FormatCurrentCluster(connection))
 
-				cmd.Println(value)
+				cmd.Println(FormatCacheSummary(cachesSummary))
 			}
 
 			// check to see if we should exit if we are not watching
@@ -664,6 +673,15 @@ func getCacheDetails(cmd *cobra.Command, args []string, displayType string) erro
 			return utils.GetError("unable to unmarshall cache result", err)
 		}
 
+		if displayType == partitionDisplayType {
+			err = recordWatchSample(cachePartitionDetails.Details)
+		} else {
+			err = recordWatchSample(cacheDetails.Details)
+		}
+		if err != nil {
+			return err
+		}
+
 		printWatchHeader(cmd)
 		cmd.Println(FormatCurrentCluster(connection))
 
@@ -929,11 +947,21 @@ batchFactor, refreshFactor or requeueThreshold.`,
 
 // formatCachesSummary returns the formatted caches for the service list.
 func formatCachesSummary(serviceList []string, dataFetcher fetcher.Fetcher) (string, error) {
-	allCachesSummary, err := getCaches(serviceList, dataFetcher)
+	allCachesSummary, err := getCachesSummary(serviceList, dataFetcher)
 	if err != nil {
 		return "", err
 	}
 
+	return FormatCacheSummary(allCachesSummary), nil
+}
+
+// getCachesSummary returns the caches for the services, ignoring special caches if requested.
+func getCachesSummary(serviceList []string, dataFetcher fetcher.Fetcher) ([]config.CacheSummaryDetail, error) {
+	allCachesSummary, err := getCaches(serviceList, dataFetcher)
+	if err != nil {
+		return nil, err
+	}
+
 	// check for ignoring of special caches including '$'
 	if ignoreSpecialCaches {
 		finalList := make([]config.CacheSummaryDetail, 0)
@@ -944,8 +972,7 @@ func formatCachesSummary(serviceList []string, dataFetcher fetcher.Fetcher) (str
 		}
 		allCachesSummary = finalList
 	}
-	value := FormatCacheSummary(allCachesSummary)
-	return value, nil
+	return allCachesSummary, nil
 }
 
 var specialCacheNames = []string{"executor-assignments", "executor-tasks", "executor-executors",
@@ -1183,6 +1210,7 @@ func findServiceForCacheOrTopic(dataFetcher fetcher.Fetcher, cacheName, serviceT
 func init() {
 	getCachesCmd.Flags().StringVarP(&serviceName, serviceNameOption, serviceNameOptionShort, "", serviceNameDescription)
 	getCachesCmd.Flags().BoolVarP(&ignoreSpecialCaches, "ignore-special", "I", false, ignoreCachesDescription)
+	getCachesCmd.Flags().StringVarP(&clusterConnections, clustersOption, "", "", clustersDescription)
 
 	describeCacheCmd.Flags().StringVarP(&serviceName, serviceNameOption, serviceNameOptionShort, "", serviceNameDescription)
 	describeViewCacheCmd.Flags().StringVarP(&serviceName, serviceNameOption, serviceNameOptionShort, "", serviceNameDescription)
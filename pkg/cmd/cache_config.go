@@ -0,0 +1,625 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	severityError            = "ERROR"
+	severityWarning          = "WARNING"
+	cacheConfigElement       = "cache-config"
+	cachingSchemesElement    = "caching-schemes"
+	schemeNameElement        = "scheme-name"
+	schemeRefElement         = "scheme-ref"
+	serviceNameElement       = "service-name"
+	backingMapSchemeElement  = "backing-map-scheme"
+	cacheStoreSchemeElement  = "cachestore-scheme"
+	expiryDelayElement       = "expiry-delay"
+	distributedSchemeElement = "distributed-scheme"
+	federatedSchemeElement   = "federated-scheme"
+	cacheNameWildcard        = "*"
+)
+
+var (
+	cacheConfigFileParam string
+
+	// expiryDelayPattern and memorySizePattern match the time and memory-size types in coherence-cache-config.xsd
+	expiryDelayPattern = regexp.MustCompile(`(?i)^\d+(\.\d+)?(ns|us|ms|s|m|h|d)?$`)
+	memorySizePattern  = regexp.MustCompile(`(?i)^\d+(\.\d+)?[kmgt]?b?$`)
+	macroPattern       = regexp.MustCompile(`^\{[^\s}]+(?:\s+([^}]*))?}$`)
+
+	memorySizeElements = []string{"high-units", "low-units"}
+	integerElements    = []string{"backup-count", "partition-count", "thread-count", "thread-count-min", "thread-count-max"}
+)
+
+// cacheConfigNode is an element in a cache configuration. A generic representation is used
+// as there are many scheme types that may be nested within each other.
+type cacheConfigNode struct {
+	XMLName  xml.Name
+	Value    string            `xml:",chardata"`
+	Children []cacheConfigNode `xml:",any"`
+}
+
+// cacheMapping maps a cache name, or a cache name ending in a wildcard, to a scheme.
+type cacheMapping struct {
+	CacheName  string `json:"cacheName"`
+	SchemeName string `json:"schemeName"`
+	SchemeType string `json:"schemeType"`
+}
+
+// cacheConfig is a parsed cache configuration.
+type cacheConfig struct {
+	mappings []cacheMapping
+	schemes  []cacheConfigNode
+}
+
+// schemeProperty is a simple value defined within a scheme.
+type schemeProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// schemeChainEntry is a scheme in the resolved chain of schemes for a cache.
+type schemeChainEntry struct {
+	Depth      int              `json:"depth"`
+	Element    string           `json:"element"`
+	SchemeName string           `json:"schemeName,omitempty"`
+	SchemeRef  string           `json:"schemeRef,omitempty"`
+	Properties []schemeProperty `json:"properties,omitempty"`
+}
+
+// resolvedCacheScheme is the chain of schemes used by a cache once scheme references are resolved.
+type resolvedCacheScheme struct {
+	CacheName   string             `json:"cacheName"`
+	Mapping     string             `json:"mapping"`
+	SchemeName  string             `json:"schemeName"`
+	SchemeType  string             `json:"schemeType"`
+	ServiceName string             `json:"serviceName"`
+	BackingMap  string             `json:"backingMap"`
+	ExpiryDelay string             `json:"expiryDelay"`
+	CacheStore  string             `json:"cacheStore"`
+	Chain       []schemeChainEntry `json:"chain"`
+}
+
+// cacheConfigIssue is an issue found when validating a cache configuration.
+type cacheConfigIssue struct {
+	Severity string `json:"severity"`
+	Element  string `json:"element"`
+	Message  string `json:"message"`
+}
+
+// describeCacheConfigCmd represents the describe cache-config command.
+var describeCacheConfigCmd = &cobra.Command{
+	Use:   "cache-config cache-name",
+	Short: "describe the resolved scheme chain for a cache",
+	Long: `The 'describe cache-config' command displays the cache mapping and resolved chain of schemes
+for a cache, including the service, backing map, expiry and cache store. The cache configuration is
+read from the cluster config, or from a local cache configuration file specified using '-f'.
+Cache configuration is only included in the cluster config in some Coherence versions.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, provideCacheMessage)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			data        []byte
+			connection  string
+			dataFetcher fetcher.Fetcher
+			err         error
+		)
+
+		if cacheConfigFileParam != "" {
+			if data, err = os.ReadFile(cacheConfigFileParam); err != nil {
+				return utils.GetError("unable to read cache config file "+cacheConfigFileParam, err)
+			}
+		} else {
+			if connection, dataFetcher, err = GetConnectionAndDataFetcher(); err != nil {
+				return err
+			}
+			if data, err = dataFetcher.GetClusterConfig(); err != nil {
+				return err
+			}
+		}
+
+		config, err := parseCacheConfig(data)
+		if err != nil {
+			return err
+		}
+
+		resolved, err := config.resolveCacheScheme(args[0])
+		if err != nil {
+			return err
+		}
+
+		if isJSONPathOrJSON() {
+			jsonData, err := json.Marshal(resolved)
+			if err != nil {
+				return err
+			}
+			return processJSONOutput(cmd, jsonData)
+		}
+
+		if connection != "" {
+			cmd.Println(FormatCurrentCluster(connection))
+		}
+		cmd.Println(FormatResolvedCacheScheme(resolved))
+
+		return nil
+	},
+}
+
+// validateCacheConfigCmd represents the validate cache-config command.
+var validateCacheConfigCmd = &cobra.Command{
+	Use:   "cache-config file-name",
+	Short: "validate a local cache configuration file",
+	Long: `The 'validate cache-config' command checks a local cache configuration file for common mistakes
+before it is used, for example by 'create cluster --cache-config'. Errors include malformed XML,
+cache mappings or scheme references to schemes that do not exist, duplicate cache mappings or
+scheme names, invalid expiry, unit or count values and services defined with different backup counts.
+Warnings include schemes that are not used and distributed schemes without a backing map scheme.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, "you must provide a cache config file name")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var fileName = args[0]
+
+		data, err := os.ReadFile(fileName)
+		if err != nil {
+			return utils.GetError("unable to read cache config file "+fileName, err)
+		}
+
+		issues := validateCacheConfig(data)
+
+		if isJSONPathOrJSON() {
+			jsonData, err := json.Marshal(issues)
+			if err != nil {
+				return err
+			}
+			if err = processJSONOutput(cmd, jsonData); err != nil {
+				return err
+			}
+		} else {
+			cmd.Println(FormatCacheConfigIssues(issues))
+		}
+
+		if errorCount := getCacheConfigErrorCount(issues); errorCount > 0 {
+			return fmt.Errorf("cache config file %s has %d error(s)", fileName, errorCount)
+		}
+
+		return nil
+	},
+}
+
+// parseCacheConfig parses a cache configuration. The cache-config element may be the root
+// element or nested within another document such as the cluster config.
+func parseCacheConfig(data []byte) (cacheConfig, error) {
+	var (
+		root   cacheConfigNode
+		config = cacheConfig{mappings: make([]cacheMapping, 0), schemes: make([]cacheConfigNode, 0)}
+	)
+
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return config, utils.GetError("unable to parse cache config", err)
+	}
+
+	node, ok := root.find(cacheConfigElement)
+	if !ok {
+		return config, errors.New("no cache configuration found, use '-f' to specify a cache config file")
+	}
+
+	if schemes, ok := node.child(cachingSchemesElement); ok {
+		config.schemes = schemes.Children
+	}
+
+	if mappings, ok := node.child("caching-scheme-mapping"); ok {
+		for _, mapping := range mappings.getChildren("cache-mapping") {
+			value := cacheMapping{CacheName: mapping.childValue("cache-name"), SchemeName: mapping.childValue(schemeNameElement)}
+			if scheme, ok := config.getScheme(value.SchemeName); ok {
+				value.SchemeType = scheme.name()
+			}
+			config.mappings = append(config.mappings, value)
+		}
+	}
+
+	return config, nil
+}
+
+// name returns the name of the element without any namespace.
+func (n cacheConfigNode) name() string {
+	return n.XMLName.Local
+}
+
+// isScheme returns true if the element is a scheme or contains a scheme.
+func (n cacheConfigNode) isScheme() bool {
+	return strings.HasSuffix(n.name(), "-scheme")
+}
+
+// child returns the first child element with the given name.
+func (n cacheConfigNode) child(name string) (cacheConfigNode, bool) {
+	for _, c := range n.Children {
+		if c.name() == name {
+			return c, true
+		}
+	}
+	return cacheConfigNode{}, false
+}
+
+// getChildren returns the child elements with the given name.
+func (n cacheConfigNode) getChildren(name string) []cacheConfigNode {
+	children := make([]cacheConfigNode, 0)
+	for _, c := range n.Children {
+		if c.name() == name {
+			children = append(children, c)
+		}
+	}
+	return children
+}
+
+// childValue returns the value of the first child element with the given name, or empty if not found.
+func (n cacheConfigNode) childValue(name string) string {
+	if c, ok := n.child(name); ok {
+		return strings.TrimSpace(c.Value)
+	}
+	return ""
+}
+
+// find returns the first element with the given name, searching depth first from this element.
+func (n cacheConfigNode) find(name string) (cacheConfigNode, bool) {
+	if n.name() == name {
+		return n, true
+	}
+	for _, c := range n.Children {
+		if found, ok := c.find(name); ok {
+			return found, true
+		}
+	}
+	return cacheConfigNode{}, false
+}
+
+// getScheme returns the top level scheme with the given scheme name.
+func (c cacheConfig) getScheme(schemeName string) (cacheConfigNode, bool) {
+	for _, scheme := range c.schemes {
+		if schemeName != "" && scheme.childValue(schemeNameElement) == schemeName {
+			return scheme, true
+		}
+	}
+	return cacheConfigNode{}, false
+}
+
+// findCacheMapping returns the mapping for a cache. An exact match is used first, otherwise
+// the wildcard mapping with the longest matching prefix.
+func (c cacheConfig) findCacheMapping(cacheName string) (cacheMapping, bool) {
+	var (
+		result  cacheMapping
+		longest = -1
+	)
+
+	for _, mapping := range c.mappings {
+		if mapping.CacheName == cacheName {
+			return mapping, true
+		}
+		prefix, isWildcard := strings.CutSuffix(mapping.CacheName, cacheNameWildcard)
+		if isWildcard && strings.HasPrefix(cacheName, prefix) && len(prefix) > longest {
+			result, longest = mapping, len(prefix)
+		}
+	}
+
+	return result, longest >= 0
+}
+
+// resolveSchemeRef returns the scheme with any scheme-ref resolved, where the elements of
+// the scheme override the elements of the referenced scheme.
+func (c cacheConfig) resolveSchemeRef(scheme cacheConfigNode, visited map[string]bool) (cacheConfigNode, error) {
+	ref := scheme.childValue(schemeRefElement)
+	if ref == "" {
+		return scheme, nil
+	}
+
+	if visited[ref] {
+		return scheme, fmt.Errorf("scheme-ref %s is cyclic", ref)
+	}
+	visited[ref] = true
+
+	referenced, ok := c.getScheme(ref)
+	if !ok {
+		return scheme, fmt.Errorf("scheme-ref %s is not defined in %s", ref, cachingSchemesElement)
+	}
+
+	referenced, err := c.resolveSchemeRef(referenced, visited)
+	if err != nil {
+		return scheme, err
+	}
+
+	resolved := cacheConfigNode{XMLName: scheme.XMLName, Children: make([]cacheConfigNode, 0)}
+	for _, child := range scheme.Children {
+		if child.name() != schemeRefElement {
+			resolved.Children = append(resolved.Children, child)
+		}
+	}
+	for _, child := range referenced.Children {
+		if _, ok := scheme.child(child.name()); !ok && child.name() != schemeNameElement {
+			resolved.Children = append(resolved.Children, child)
+		}
+	}
+
+	return resolved, nil
+}
+
+// resolveCacheScheme returns the resolved chain of schemes for a cache.
+func (c cacheConfig) resolveCacheScheme(cacheName string) (resolvedCacheScheme, error) {
+	mapping, ok := c.findCacheMapping(cacheName)
+	if !ok {
+		return resolvedCacheScheme{}, fmt.Errorf("no cache mapping found for cache %s", cacheName)
+	}
+
+	scheme, ok := c.getScheme(mapping.SchemeName)
+	if !ok {
+		return resolvedCacheScheme{}, fmt.Errorf("scheme %s for cache mapping %s is not defined", mapping.SchemeName, mapping.CacheName)
+	}
+
+	result := resolvedCacheScheme{CacheName: cacheName, Mapping: mapping.CacheName, SchemeName: mapping.SchemeName,
+		SchemeType: scheme.name(), Chain: make([]schemeChainEntry, 0)}
+
+	return result, c.addSchemeChain(&result, scheme, 0, "")
+}
+
+// addSchemeChain adds a scheme and any nested schemes to the resolved chain, recording the
+// service name, backing map, expiry and cache store as they are found.
+func (c cacheConfig) addSchemeChain(result *resolvedCacheScheme, scheme cacheConfigNode, depth int, parent string) error {
+	resolved, err := c.resolveSchemeRef(scheme, make(map[string]bool))
+	if err != nil {
+		return err
+	}
+
+	entry := schemeChainEntry{Depth: depth, Element: scheme.name(), SchemeName: scheme.childValue(schemeNameElement),
+		SchemeRef: scheme.childValue(schemeRefElement)}
+
+	for _, child := range resolved.Children {
+		if len(child.Children) == 0 && !child.isScheme() && child.name() != schemeNameElement {
+			entry.Properties = append(entry.Properties, schemeProperty{Name: child.name(), Value: strings.TrimSpace(child.Value)})
+		}
+	}
+
+	if depth == 0 {
+		result.ServiceName = resolved.childValue(serviceNameElement)
+	}
+	if result.ExpiryDelay == "" {
+		result.ExpiryDelay = resolved.childValue(expiryDelayElement)
+	}
+
+	switch parent {
+	case backingMapSchemeElement:
+		if result.BackingMap == "" {
+			result.BackingMap = scheme.name()
+		}
+	case cacheStoreSchemeElement:
+		if result.CacheStore == "" {
+			result.CacheStore = getFirstNonEmpty(resolved.childValue("class-name"), resolved.childValue("class-factory-name"), scheme.name())
+		}
+	}
+
+	result.Chain = append(result.Chain, entry)
+
+	for _, child := range resolved.Children {
+		if child.isScheme() {
+			if err = c.addSchemeChain(result, child, depth+1, scheme.name()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCacheConfig validates a cache configuration and returns any issues found.
+func validateCacheConfig(data []byte) []cacheConfigIssue {
+	var (
+		issues         = make([]cacheConfigIssue, 0)
+		cacheNames     = make(map[string]bool)
+		schemeNames    = make(map[string]bool)
+		referenced     = make(map[string]bool)
+		serviceSchemes = make(map[string]cacheConfigNode)
+		addIssue       = func(severity, element, format string, args ...any) {
+			issues = append(issues, cacheConfigIssue{Severity: severity, Element: element, Message: fmt.Sprintf(format, args...)})
+		}
+		root cacheConfigNode
+	)
+
+	if err := xml.Unmarshal(data, &root); err != nil {
+		addIssue(severityError, cacheConfigElement, "malformed XML: %v", err)
+		return issues
+	}
+
+	if root.name() != cacheConfigElement {
+		addIssue(severityError, root.name(), "root element must be %s", cacheConfigElement)
+		return issues
+	}
+
+	config, _ := parseCacheConfig(data)
+
+	if len(config.mappings) == 0 {
+		addIssue(severityWarning, "caching-scheme-mapping", "no cache mappings are defined")
+	}
+
+	for _, mapping := range config.mappings {
+		element := fmt.Sprintf("cache-mapping[%s]", mapping.CacheName)
+		switch {
+		case mapping.CacheName == "":
+			addIssue(severityError, element, "cache-name is not defined")
+		case cacheNames[mapping.CacheName]:
+			addIssue(severityError, element, "cache-name %s is mapped more than once", mapping.CacheName)
+		case strings.Contains(strings.TrimSuffix(mapping.CacheName, cacheNameWildcard), cacheNameWildcard):
+			addIssue(severityError, element, "a wildcard may only be used at the end of a cache-name")
+		}
+		cacheNames[mapping.CacheName] = true
+
+		if mapping.SchemeName == "" {
+			addIssue(severityError, element, "scheme-name is not defined")
+		} else if mapping.SchemeType == "" {
+			addIssue(severityError, element, "scheme %s is not defined in %s", mapping.SchemeName, cachingSchemesElement)
+		}
+		referenced[mapping.SchemeName] = true
+	}
+
+	for _, scheme := range config.schemes {
+		schemeName := scheme.childValue(schemeNameElement)
+		element := fmt.Sprintf("%s[%s]", scheme.name(), schemeName)
+
+		if schemeName != "" && schemeNames[schemeName] {
+			addIssue(severityError, element, "scheme-name %s is defined more than once", schemeName)
+		}
+		schemeNames[schemeName] = true
+
+		issues = append(issues, config.validateScheme(scheme, element, referenced)...)
+
+		resolved, err := config.resolveSchemeRef(scheme, make(map[string]bool))
+		if err != nil {
+			continue
+		}
+
+		if resolved.name() == distributedSchemeElement || resolved.name() == federatedSchemeElement {
+			if _, ok := resolved.child(backingMapSchemeElement); !ok {
+				addIssue(severityWarning, element, "no %s is defined", backingMapSchemeElement)
+			}
+		}
+
+		// schemes with the same service name must be consistent
+		if serviceName := resolved.childValue(serviceNameElement); serviceName != "" {
+			if existing, ok := serviceSchemes[serviceName]; ok {
+				if existing.name() != resolved.name() {
+					addIssue(severityError, element, "service %s is also defined by a %s", serviceName, existing.name())
+				} else if getBackupCount(existing) != getBackupCount(resolved) {
+					addIssue(severityError, element, "service %s is defined with different backup-count values", serviceName)
+				}
+			} else {
+				serviceSchemes[serviceName] = resolved
+			}
+		}
+	}
+
+	for _, scheme := range config.schemes {
+		schemeName := scheme.childValue(schemeNameElement)
+		if referenced[schemeName] || strings.EqualFold(scheme.childValue("autostart"), stringTrue) {
+			continue
+		}
+		element := fmt.Sprintf("%s[%s]", scheme.name(), schemeName)
+		if schemeName == "" {
+			addIssue(severityWarning, element, "scheme has no scheme-name and is not started automatically")
+		} else {
+			addIssue(severityWarning, element, "scheme %s is not used by any cache-mapping or scheme-ref", schemeName)
+		}
+	}
+
+	return issues
+}
+
+// validateScheme validates the values in a scheme and any nested schemes, and records the
+// scheme references that are used.
+func (c cacheConfig) validateScheme(scheme cacheConfigNode, element string, referenced map[string]bool) []cacheConfigIssue {
+	issues := make([]cacheConfigIssue, 0)
+
+	if ref := scheme.childValue(schemeRefElement); ref != "" {
+		referenced[ref] = true
+		if _, err := c.resolveSchemeRef(scheme, make(map[string]bool)); err != nil {
+			issues = append(issues, cacheConfigIssue{Severity: severityError, Element: element, Message: err.Error()})
+		}
+	}
+
+	for _, child := range scheme.Children {
+		var (
+			name    = child.name()
+			value   = strings.TrimSpace(child.Value)
+			message string
+		)
+
+		if child.isScheme() {
+			issues = append(issues, c.validateScheme(child, element+"/"+name, referenced)...)
+			continue
+		}
+
+		if value = getMacroDefault(value); value == "" {
+			continue
+		}
+
+		switch {
+		case name == expiryDelayElement && !expiryDelayPattern.MatchString(value):
+			message = "must be a duration such as 10s, 5m or 1h"
+		case utils.SliceContains(memorySizeElements, name) && !memorySizePattern.MatchString(value):
+			message = "must be a number of units or a memory size such as 100MB"
+		case utils.SliceContains(integerElements, name):
+			if v, err := strconv.Atoi(value); err != nil || v < 0 {
+				message = "must be a non-negative integer"
+			}
+		}
+
+		if message != "" {
+			issues = append(issues, cacheConfigIssue{Severity: severityError, Element: element,
+				Message: fmt.Sprintf("invalid %s value %s, %s", name, value, message)})
+		}
+	}
+
+	return issues
+}
+
+// getBackupCount returns the backup-count for a scheme, which defaults to 1 if it is not specified.
+func getBackupCount(scheme cacheConfigNode) string {
+	if value := getMacroDefault(scheme.childValue("backup-count")); value != "" {
+		return value
+	}
+	return "1"
+}
+
+// getMacroDefault returns the default value of a parameter macro such as {back-expiry 10m},
+// an empty string if the macro has no default, or the value itself if it is not a macro.
+func getMacroDefault(value string) string {
+	if matches := macroPattern.FindStringSubmatch(value); matches != nil {
+		return strings.TrimSpace(matches[1])
+	}
+	return value
+}
+
+// getCacheConfigErrorCount returns the number of errors in the issues.
+func getCacheConfigErrorCount(issues []cacheConfigIssue) int {
+	count := 0
+	for _, issue := range issues {
+		if issue.Severity == severityError {
+			count++
+		}
+	}
+	return count
+}
+
+// getFirstNonEmpty returns the first value that is not empty.
+func getFirstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func init() {
+	describeCacheConfigCmd.Flags().StringVarP(&cacheConfigFileParam, "file", "f", "", "local cache config file to use instead of the cluster config")
+}
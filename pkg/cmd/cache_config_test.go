@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"testing"
+)
+
+const testCacheConfig = `<?xml version="1.0"?>
+<cache-config xmlns="http://xmlns.oracle.com/coherence/coherence-cache-config">
+  <caching-scheme-mapping>
+    <cache-mapping>
+      <cache-name>orders-*</cache-name>
+      <scheme-name>server</scheme-name>
+    </cache-mapping>
+    <cache-mapping>
+      <cache-name>*</cache-name>
+      <scheme-name>base</scheme-name>
+    </cache-mapping>
+  </caching-scheme-mapping>
+  <caching-schemes>
+    <distributed-scheme>
+      <scheme-name>server</scheme-name>
+      <scheme-ref>base</scheme-ref>
+      <backing-map-scheme>
+        <read-write-backing-map-scheme>
+          <internal-cache-scheme>
+            <local-scheme>
+              <scheme-ref>expiring</scheme-ref>
+            </local-scheme>
+          </internal-cache-scheme>
+          <cachestore-scheme>
+            <class-scheme>
+              <class-name>com.example.OrderStore</class-name>
+            </class-scheme>
+          </cachestore-scheme>
+        </read-write-backing-map-scheme>
+      </backing-map-scheme>
+    </distributed-scheme>
+    <distributed-scheme>
+      <scheme-name>base</scheme-name>
+      <service-name>PartitionedCache</service-name>
+      <backing-map-scheme>
+        <local-scheme/>
+      </backing-map-scheme>
+      <autostart>true</autostart>
+    </distributed-scheme>
+    <local-scheme>
+      <scheme-name>expiring</scheme-name>
+      <expiry-delay>{orders-expiry 1h}</expiry-delay>
+      <high-units>100MB</high-units>
+    </local-scheme>
+  </caching-schemes>
+</cache-config>`
+
+func TestResolveCacheScheme(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	config, err := parseCacheConfig([]byte(testCacheConfig))
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(config.mappings)).To(gomega.Equal(2))
+
+	resolved, err := config.resolveCacheScheme("orders-2026")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(resolved.Mapping).To(gomega.Equal("orders-*"))
+	g.Expect(resolved.ServiceName).To(gomega.Equal("PartitionedCache"))
+	g.Expect(resolved.BackingMap).To(gomega.Equal("read-write-backing-map-scheme"))
+	g.Expect(resolved.ExpiryDelay).To(gomega.Equal("{orders-expiry 1h}"))
+	g.Expect(resolved.CacheStore).To(gomega.Equal("com.example.OrderStore"))
+
+	// the wildcard mapping is used for any other cache
+	resolved, err = config.resolveCacheScheme("customers")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(resolved.SchemeName).To(gomega.Equal("base"))
+	g.Expect(resolved.BackingMap).To(gomega.Equal("local-scheme"))
+	g.Expect(resolved.CacheStore).To(gomega.Equal(""))
+
+	_, err = parseCacheConfig([]byte("<coherence/>"))
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestValidateCacheConfig(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	g.Expect(validateCacheConfig([]byte(testCacheConfig))).To(gomega.BeEmpty())
+
+	issues := validateCacheConfig([]byte(`<cache-config>
+  <caching-scheme-mapping>
+    <cache-mapping><cache-name>test</cache-name><scheme-name>missing</scheme-name></cache-mapping>
+  </caching-scheme-mapping>
+  <caching-schemes>
+    <distributed-scheme>
+      <scheme-name>server</scheme-name>
+      <backup-count>one</backup-count>
+      <backing-map-scheme>
+        <local-scheme><expiry-delay>10 minutes</expiry-delay><scheme-ref>unknown</scheme-ref></local-scheme>
+      </backing-map-scheme>
+    </distributed-scheme>
+  </caching-schemes>
+</cache-config>`))
+
+	g.Expect(getCacheConfigErrorCount(issues)).To(gomega.Equal(4))
+	g.Expect(issues[0].Message).To(gomega.Equal("scheme missing is not defined in caching-schemes"))
+	g.Expect(issues[1].Message).To(gomega.Equal("invalid backup-count value one, must be a non-negative integer"))
+	g.Expect(issues[2].Element).To(gomega.Equal("distributed-scheme[server]/backing-map-scheme/local-scheme"))
+	g.Expect(issues[4].Severity).To(gomega.Equal(severityWarning))
+
+	// decimal memory sizes, all time units and the default backup-count are valid
+	issues = validateCacheConfig([]byte(`<cache-config>
+  <caching-scheme-mapping>
+    <cache-mapping><cache-name>a</cache-name><scheme-name>a</scheme-name></cache-mapping>
+    <cache-mapping><cache-name>b</cache-name><scheme-name>b</scheme-name></cache-mapping>
+  </caching-scheme-mapping>
+  <caching-schemes>
+    <distributed-scheme>
+      <scheme-name>a</scheme-name>
+      <service-name>Shared</service-name>
+      <backing-map-scheme>
+        <local-scheme><high-units>1.5GB</high-units><expiry-delay>500us</expiry-delay></local-scheme>
+      </backing-map-scheme>
+    </distributed-scheme>
+    <distributed-scheme>
+      <scheme-name>b</scheme-name>
+      <service-name>Shared</service-name>
+      <backup-count>1</backup-count>
+      <backing-map-scheme>
+        <local-scheme><high-units>10k</high-units><expiry-delay>10ns</expiry-delay></local-scheme>
+      </backing-map-scheme>
+    </distributed-scheme>
+  </caching-schemes>
+</cache-config>`))
+	g.Expect(issues).To(gomega.BeEmpty())
+
+	issues = validateCacheConfig([]byte("<cache-config>"))
+	g.Expect(getCacheConfigErrorCount(issues)).To(gomega.Equal(1))
+}
@@ -0,0 +1,332 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultBackupCount = 1
+
+var (
+	heapThresholdParam int32
+	targetSizeParam    string
+	dataSizeRegex      = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*(B|KB|MB|GB|TB)?$`)
+)
+
+// serviceCapacity contains the data stored by a distributed service.
+type serviceCapacity struct {
+	ServiceName          string `json:"serviceName"`
+	BackupCount          int    `json:"backupCount"`
+	Caches               int    `json:"caches"`
+	PrimaryBytes         int64  `json:"primaryBytes"`
+	BackupBytes          int64  `json:"backupBytes"`
+	PartitionCount       int32  `json:"partitionCount"`
+	MaxPartitionSizeKB   int64  `json:"maxPartitionSizeKB"`
+	AverageStorageSizeKB int64  `json:"averageStorageSizeKB"`
+}
+
+// capacityReport is the capacity report for a cluster.
+type capacityReport struct {
+	StorageMembers        int               `json:"storageMembers"`
+	TotalHeapBytes        int64             `json:"totalHeapBytes"`
+	UsedHeapBytes         int64             `json:"usedHeapBytes"`
+	HeapThreshold         int32             `json:"heapThreshold"`
+	ThresholdHeapBytes    int64             `json:"thresholdHeapBytes"`
+	HeadroomBytes         int64             `json:"headroomBytes"`
+	PrimaryBytes          int64             `json:"primaryBytes"`
+	BackupBytes           int64             `json:"backupBytes"`
+	MemberOverheadBytes   int64             `json:"memberOverheadBytes"`
+	ElasticDataRAMBytes   int64             `json:"elasticDataRamBytes"`
+	ElasticDataFlashBytes int64             `json:"elasticDataFlashBytes"`
+	MinBackupCount        int               `json:"minBackupCount"`
+	MembersRequired       int               `json:"membersRequired"`
+	TolerableMemberLoss   int               `json:"tolerableMemberLoss"`
+	TargetPrimaryBytes    int64             `json:"targetPrimaryBytes,omitempty"`
+	MembersForTarget      int               `json:"membersForTarget,omitempty"`
+	Services              []serviceCapacity `json:"services"`
+}
+
+// getCapacityCmd represents the get capacity command.
+var getCapacityCmd = &cobra.Command{
+	Use:   "capacity",
+	Short: "display a capacity planning report based upon the current cluster usage",
+	Long: `The 'get capacity' command displays a capacity planning report using the heap of the storage
+members, the memory used by caches including backups, and elastic data usage. The headroom is the
+heap available before the heap threshold is reached. The tolerable member loss is the number of storage
+members that can be lost, one at a time, while keeping the heap used below the threshold once the data is
+rebalanced. Use '-S' to project the number of members required for a target primary data size.`,
+	Args: cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		var (
+			members     = config.Members{}
+			targetBytes int64
+		)
+
+		if heapThresholdParam < 1 || heapThresholdParam > 100 {
+			return errors.New("heap threshold must be between 1 and 100")
+		}
+
+		if targetSizeParam != "" {
+			var err error
+			if targetBytes, err = parseDataSize(targetSizeParam); err != nil {
+				return err
+			}
+		}
+
+		connection, dataFetcher, err := GetConnectionAndDataFetcher()
+		if err != nil {
+			return err
+		}
+
+		membersResult, err := dataFetcher.GetMemberDetailsJSON(false)
+		if err != nil {
+			return err
+		}
+
+		if err = json.Unmarshal(membersResult, &members); err != nil {
+			return utils.GetError(unableToDecode, err)
+		}
+
+		services, err := getServiceCapacity(dataFetcher)
+		if err != nil {
+			return err
+		}
+
+		ramBytes, err := getElasticDataSize(dataFetcher, "ram")
+		if err != nil {
+			return err
+		}
+
+		flashBytes, err := getElasticDataSize(dataFetcher, flashString)
+		if err != nil {
+			return err
+		}
+
+		report := getCapacityReport(members.Members, services, heapThresholdParam, targetBytes)
+		report.ElasticDataRAMBytes = ramBytes
+		report.ElasticDataFlashBytes = flashBytes
+
+		if isJSONPathOrJSON() {
+			jsonData, err := json.Marshal(report)
+			if err != nil {
+				return err
+			}
+			return processJSONOutput(cmd, jsonData)
+		}
+
+		cmd.Println(FormatCurrentCluster(connection))
+		cmd.Println(FormatCapacityReport(report))
+		cmd.Println(FormatServiceCapacity(report.Services))
+
+		return nil
+	},
+}
+
+// getServiceCapacity returns the data stored by each distributed service.
+func getServiceCapacity(dataFetcher fetcher.Fetcher) ([]serviceCapacity, error) {
+	var (
+		cacheSummaries = config.CacheSummaries{}
+		capacities     = make(map[string]*serviceCapacity)
+		result         = make([]serviceCapacity, 0)
+	)
+
+	storageSummaries, err := getServiceStorageDetails(dataFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, value := range storageSummaries {
+		capacities[value.ServiceName] = &serviceCapacity{ServiceName: value.ServiceName,
+			BackupCount: getServiceBackupCount(dataFetcher, value.ServiceName), PartitionCount: value.PartitionCount,
+			MaxPartitionSizeKB: value.MaxPartitionSizeKB, AverageStorageSizeKB: value.AverageStorageSizeKB}
+	}
+
+	cachesResult, err := dataFetcher.GetCachesSummaryJSONAllServices()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(cachesResult, &cacheSummaries); err != nil {
+		return nil, utils.GetError("unable to unmarshall cache summary", err)
+	}
+
+	for _, cache := range cacheSummaries.Caches {
+		capacity, ok := capacities[cache.ServiceName]
+		if !ok {
+			continue
+		}
+
+		var cacheDetails = config.CacheDetails{}
+		cacheResult, err := dataFetcher.GetCacheMembers(cache.ServiceName, cache.CacheName)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(cacheResult) > 0 {
+			if err = json.Unmarshal(cacheResult, &cacheDetails); err != nil {
+				return nil, utils.GetError("unable to unmarshall cache members", err)
+			}
+		}
+
+		capacity.Caches++
+		for _, value := range cacheDetails.Details {
+			if value.Tier == "back" {
+				capacity.PrimaryBytes += value.Units * value.UnitFactor
+			}
+		}
+	}
+
+	for _, capacity := range capacities {
+		capacity.BackupBytes = capacity.PrimaryBytes * int64(capacity.BackupCount)
+		result = append(result, *capacity)
+	}
+
+	sort.Slice(result, func(p, q int) bool {
+		return result[p].ServiceName < result[q].ServiceName
+	})
+
+	return result, nil
+}
+
+// getServiceBackupCount returns the backup count for a service from the service description, or
+// the default backup count if the description is not available in this version of Coherence.
+func getServiceBackupCount(dataFetcher fetcher.Fetcher, service string) int {
+	var description = config.Description{}
+
+	data, err := dataFetcher.GetServiceDescriptionJSON(service)
+	if err != nil || len(data) == 0 || json.Unmarshal(data, &description) != nil {
+		return defaultBackupCount
+	}
+
+	if matches := backupCountPattern.FindStringSubmatch(description.Description); len(matches) > 1 {
+		if backupCount, err := strconv.Atoi(matches[1]); err == nil {
+			return backupCount
+		}
+	}
+
+	return defaultBackupCount
+}
+
+// getElasticDataSize returns the total elastic data size for the type of flash or ram.
+func getElasticDataSize(dataFetcher fetcher.Fetcher, journalType string) (int64, error) {
+	var (
+		elasticData = config.ElasticDataValues{}
+		total       int64
+	)
+
+	data, err := dataFetcher.GetElasticDataDetails(journalType)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &elasticData); err != nil {
+			return 0, utils.GetError("unable to unmarshall elastic data", err)
+		}
+	}
+
+	for _, value := range elasticData.ElasticData {
+		total += value.TotalDataSize
+	}
+
+	return total, nil
+}
+
+// getCapacityReport calculates the capacity for the storage members and services. The heap used by
+// a member that is not cache data is treated as a fixed overhead for each member.
+func getCapacityReport(members []config.Member, services []serviceCapacity, threshold int32, targetBytes int64) capacityReport {
+	var report = capacityReport{HeapThreshold: threshold, TargetPrimaryBytes: targetBytes, Services: services,
+		MembersRequired: -1, TolerableMemberLoss: -1}
+
+	for _, member := range members {
+		if !member.StorageEnabled {
+			continue
+		}
+		report.StorageMembers++
+		report.TotalHeapBytes += int64(member.MemoryMaxMB) * MB
+		report.UsedHeapBytes += int64(member.MemoryMaxMB-member.MemoryAvailableMB) * MB
+	}
+
+	report.MinBackupCount = -1
+	for _, service := range services {
+		report.PrimaryBytes += service.PrimaryBytes
+		report.BackupBytes += service.BackupBytes
+		if report.MinBackupCount == -1 || service.BackupCount < report.MinBackupCount {
+			report.MinBackupCount = service.BackupCount
+		}
+	}
+
+	if report.StorageMembers == 0 {
+		return report
+	}
+
+	var (
+		dataBytes       = report.PrimaryBytes + report.BackupBytes
+		averageHeap     = float64(report.TotalHeapBytes) / float64(report.StorageMembers)
+		usableHeap      float64
+		requiredMembers = func(data int64) int {
+			return max(int(math.Ceil(float64(data)/usableHeap)), 1)
+		}
+	)
+
+	report.ThresholdHeapBytes = report.TotalHeapBytes * int64(threshold) / 100
+	report.HeadroomBytes = report.ThresholdHeapBytes - report.UsedHeapBytes
+	report.MemberOverheadBytes = max(report.UsedHeapBytes-dataBytes, 0) / int64(report.StorageMembers)
+
+	// the heap available for data in each member while staying within the threshold
+	usableHeap = averageHeap*float64(threshold)/100 - float64(report.MemberOverheadBytes)
+	if usableHeap <= 0 {
+		return report
+	}
+
+	report.MembersRequired = requiredMembers(dataBytes)
+	report.TolerableMemberLoss = max(report.StorageMembers-report.MembersRequired, 0)
+
+	if targetBytes > 0 {
+		backupFactor := float64(report.MinBackupCount)
+		if report.PrimaryBytes > 0 {
+			backupFactor = float64(report.BackupBytes) / float64(report.PrimaryBytes)
+		}
+		report.MembersForTarget = requiredMembers(int64(float64(targetBytes) * (1 + backupFactor)))
+	}
+
+	return report
+}
+
+// parseDataSize parses a data size such as 500MB or 1.5TB and returns the number of bytes.
+func parseDataSize(value string) (int64, error) {
+	matches := dataSizeRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(value)))
+	if matches == nil {
+		return 0, fmt.Errorf("invalid data size %s, must be a number followed by B, KB, MB, GB or TB", value)
+	}
+
+	size, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, err
+	}
+
+	multiplier := map[string]int64{"": 1, "B": 1, "KB": KB, "MB": MB, "GB": GB, "TB": GB * KB}[matches[2]]
+
+	return int64(size * float64(multiplier)), nil
+}
+
+func init() {
+	getCapacityCmd.Flags().Int32VarP(&heapThresholdParam, "heap-threshold", "T", 80, "maximum percentage of heap to be used")
+	getCapacityCmd.Flags().StringVarP(&targetSizeParam, "target-size", "S", "", "target primary data size to project members required for, e.g. 500GB")
+}
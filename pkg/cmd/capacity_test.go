@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"testing"
+)
+
+func TestCapacityReport(t *testing.T) {
+	var (
+		g       = gomega.NewGomegaWithT(t)
+		members = []config.Member{
+			{NodeID: "1", StorageEnabled: true, MemoryMaxMB: 1024, MemoryAvailableMB: 624},
+			{NodeID: "2", StorageEnabled: true, MemoryMaxMB: 1024, MemoryAvailableMB: 624},
+			{NodeID: "3", StorageEnabled: true, MemoryMaxMB: 1024, MemoryAvailableMB: 624},
+			{NodeID: "4", StorageEnabled: true, MemoryMaxMB: 1024, MemoryAvailableMB: 624},
+			{NodeID: "5", StorageEnabled: false, MemoryMaxMB: 4096, MemoryAvailableMB: 0},
+		}
+		services = []serviceCapacity{
+			{ServiceName: "PartitionedCache", BackupCount: 1, PrimaryBytes: 600 * MB, BackupBytes: 600 * MB},
+		}
+	)
+
+	report := getCapacityReport(members, services, 80, 3*GB)
+
+	// storage disabled members are not included
+	g.Expect(report.StorageMembers).To(gomega.Equal(4))
+	g.Expect(report.TotalHeapBytes).To(gomega.Equal(4 * GB))
+	g.Expect(report.UsedHeapBytes).To(gomega.Equal(1600 * MB))
+	g.Expect(report.HeadroomBytes).To(gomega.Equal(4*GB*80/100 - 1600*MB))
+	g.Expect(report.MemberOverheadBytes).To(gomega.Equal(100 * MB))
+	g.Expect(report.MinBackupCount).To(gomega.Equal(1))
+
+	// each member can hold 819.2MB - 100MB of data, so 1200MB requires 2 members
+	g.Expect(report.MembersRequired).To(gomega.Equal(2))
+	g.Expect(report.TolerableMemberLoss).To(gomega.Equal(2))
+
+	// 3GB of primary and 3GB of backup data requires 9 members
+	g.Expect(report.MembersForTarget).To(gomega.Equal(9))
+
+	// the overhead is more than the threshold
+	report = getCapacityReport(members, services, 5, 0)
+	g.Expect(report.MembersRequired).To(gomega.Equal(-1))
+	g.Expect(report.TolerableMemberLoss).To(gomega.Equal(-1))
+
+	size, err := parseDataSize("1.5gb")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(size).To(gomega.Equal(GB + 512*MB))
+	_, err = parseDataSize("lots")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,18 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// checkCmd represents the check command.
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "check a resource",
+	Long:  `The 'check' command checks various resources.`,
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -27,11 +28,12 @@ import (
 )
 
 var (
-	connectionURL  string
-	connectionType string
-	verboseOutput  bool
-	ignoreErrors   bool
-	timeout        int32
+	connectionURL        string
+	connectionType       string
+	verboseOutput        bool
+	ignoreErrors         bool
+	timeout              int32
+	addClusterCredential string
 
 	validSetClusterAttributes = []string{
 		"bufferPublishSize", "bufferReceiveSize", "loggingLevel", "loggingLimit", "loggingFormat",
@@ -47,6 +49,7 @@ const (
 	ignoreErrorsMessage              = "ignore errors from NS lookup"
 	youMustProviderConnectionMessage = "you must provide a single connection name"
 	httpType                         = "http"
+	replayType                       = "replay"
 )
 
 // addClusterCmd represents the add cluster command
@@ -56,7 +59,11 @@ var addClusterCmd = &cobra.Command{
 	Long: `The 'add cluster' command adds a new connection to a Coherence cluster. You can
 specify the full url such as https://<host>:<management-port>/management/coherence/cluster.
 You can also specify host:port (for http connections) and the url will be automatically
-populated constructed.`,
+populated constructed. If the type is 'replay' then the url is a directory or .tar.gz archive
+containing management responses recorded using the --record-responses option, and commands
+are served from the recording rather than a live cluster. Use '-C' to specify a credential,
+added using 'add credential', to authenticate to the cluster, and the '--tls-*' options to specify
+TLS configuration for this connection rather than using the COHERENCE_TLS_* environment variables.`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
 			displayErrorAndExit(cmd, youMustProviderConnectionMessage)
@@ -680,7 +687,14 @@ you can confirm if you wish to add the discovered clusters.`,
 func addCluster(cmd *cobra.Command, connection, connectionURL, discoveryType, nsAddress string) error {
 	// check to see if the url is just host:port and then build the full management URL using http as default
 	// otherwise let it fall through and get validated
-	if !strings.Contains(connectionURL, httpType) {
+	if connectionType == replayType {
+		// store the full path to the recording so the connection can be used from any directory
+		absolutePath, err := filepath.Abs(connectionURL)
+		if err != nil {
+			return err
+		}
+		connectionURL = absolutePath
+	} else if !strings.Contains(connectionURL, httpType) {
 		split := strings.Split(connectionURL, ":")
 		if len(split) == 2 {
 			// candidate, second value must be int
@@ -692,7 +706,15 @@ func addCluster(cmd *cobra.Command, connection, connectionURL, discoveryType, ns
 
 	isWebLogic := fetcher.IsWebLogicServer(connectionURL)
 
-	dataFetcher, err := fetcher.GetFetcherOrError(connectionType, connectionURL, Username, "")
+	var auth *fetcher.Auth
+	if addClusterCredential != "" && connectionType == httpType {
+		var err error
+		if auth, err = getCredentialAuth(addClusterCredential); err != nil {
+			return err
+		}
+	}
+
+	dataFetcher, err := fetcher.GetFetcherWithOptions(connectionType, connectionURL, Username, "", auth, getTLSOptionsFromFlags())
 	if err != nil {
 		return err
 	}
@@ -715,11 +737,15 @@ func addCluster(cmd *cobra.Command, connection, connectionURL, discoveryType, ns
 	if isWebLogic {
 		clusterType = "WebLogic"
 	}
+	if connectionType == replayType {
+		clusterType = "Replay"
+	}
 
 	// add the new cluster
 	newCluster := ClusterConnection{Name: connection, ConnectionType: connectionType, ConnectionURL: connectionURL,
 		DiscoveryType: discoveryType, ClusterVersion: cluster.Version, ClusterName: cluster.ClusterName,
-		ClusterType: clusterType, NameServiceDiscovery: nsAddress}
+		ClusterType: clusterType, NameServiceDiscovery: nsAddress, Credential: addClusterCredential}
+	newCluster.setTLSOptions(getTLSOptionsFromFlags())
 
 	Config.Clusters = append(Config.Clusters, newCluster)
 
@@ -767,6 +793,7 @@ var (
 	statementParam           string
 	logDestinationParam      string
 	cacheConfigParam         string
+	showCacheMappings        bool
 	operationalConfigParam   string
 	settingsFileParam        string
 	dynamicHTTPParam         bool
@@ -777,6 +804,7 @@ const (
 	startClusterCommand     = "start cluster"
 	scaleClusterCommand     = "scale cluster"
 	stopClusterCommand      = "stop cluster"
+	superviseClusterCommand = "supervise cluster"
 	defaultHeap             = "128m"
 	localHost               = "127.0.0.1"
 	minPartitionCount       = 1
@@ -792,6 +820,8 @@ and starts it. You must have the 'mvn' executable and 'java' 17+ executable in y
 this to work. This cluster is only for development/testing purposes and should not be used, 
 and is not supported in a production capacity. Supported versions are: CE 22.06 and above and 
 commercial 14.1.1.2206.1 and above. Default version is currently CE ` + defaultCoherenceVersion + `.
+You can use '--topology' to specify a YAML file describing groups of members, each with their own
+replicas, role, heap, profile, JVM arguments, machine, rack, site and cache or override config.
 NOTE: This is an experimental feature and my be altered or removed in the future.`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
@@ -806,6 +836,8 @@ NOTE: This is an experimental feature and my be altered or removed in the future
 			groupID        = getCoherenceGroupID()
 			cpEntry        string
 			splitArtifacts []string
+			topology       []MemberGroup
+			memberCount    = replicaCountParam
 		)
 
 		// validate the Java and Maven/Gradle executable are present and in the path
@@ -879,7 +911,7 @@ NOTE: This is an experimental feature and my be altered or removed in the future
 			return err
 		}
 
-		err = validateOverrideParams()
+		err = validateOverrideParams(cmd)
 		if err != nil {
 			return err
 		}
@@ -903,6 +935,19 @@ NOTE: This is an experimental feature and my be altered or removed in the future
 			return errors.New("replica count must be 1 or more")
 		}
 
+		// validate the topology file, which replaces the per-member flags
+		if topologyFileParam != "" {
+			for _, flag := range topologyExclusiveFlags {
+				if cmd.Flags().Changed(flag) {
+					return fmt.Errorf("the --%s option cannot be specified with --%s", flag, topologyArg)
+				}
+			}
+			if topology, err = loadTopology(topologyFileParam); err != nil {
+				return err
+			}
+			memberCount = getTopologyMemberCount(topology)
+		}
+
 		// validate ensure unique cluster name
 		if err = ensureUniqueCluster(clusterName); err != nil {
 			return err
@@ -935,7 +980,7 @@ NOTE: This is an experimental feature and my be altered or removed in the future
 		cmd.Printf("Cluster port:         %d\n", clusterPortParam)
 		cmd.Printf("Management port:      %d\n", httpPortParam)
 		cmd.Printf("Partition count:      %d\n", partitionCountParam)
-		cmd.Printf("Replica count:        %d\n", replicaCountParam)
+		cmd.Printf("Replica count:        %d\n", memberCount)
 		cmd.Printf("Initial memory:       %s\n", heap)
 		cmd.Printf("Persistence mode:     %s\n", persistenceModeParam)
 		cmd.Printf("Group ID:             %s\n", groupID)
@@ -946,6 +991,12 @@ NOTE: This is an experimental feature and my be altered or removed in the future
 		cmd.Printf("Operational Override: %v\n", operationalConfigParam)
 		cmd.Printf("Startup Class:        %v\n", serverStartClassParam)
 		cmd.Printf("Dependency tool:      %v\n", getExecType())
+		if len(topology) > 0 {
+			cmd.Printf("Topology file:        %v\n", topologyFileParam)
+			for _, g := range topology {
+				cmd.Printf("  - %s\n", getMemberGroupDescription(g))
+			}
+		}
 
 		// confirm the operation
 		if !confirmOperation(cmd, "Are you sure you want to create the cluster with the above details? (y/n) ") {
@@ -1037,9 +1088,9 @@ NOTE: This is an experimental feature and my be altered or removed in the future
 			ManuallyCreated: true, ClusterVersion: clusterVersionParam, ClusterName: clusterName,
 			ClusterType: "Standalone", BaseClasspath: strings.Join(classpath, getClasspathSeparator()),
 			Arguments: arguments, ManagementPort: httpPortParam, PersistenceMode: persistenceModeParam,
-			LoggingDestination: logDestinationParam, StartupClass: serverStartClassParam}
+			LoggingDestination: logDestinationParam, StartupClass: serverStartClassParam, Topology: topology}
 
-		cmd.Printf("Starting %d cluster members for cluster %s\n", replicaCountParam, clusterName)
+		cmd.Printf("Starting %d cluster members for cluster %s\n", memberCount, clusterName)
 
 		err = startCluster(cmd, newCluster, replicaCountParam, 0)
 
@@ -1070,7 +1121,8 @@ var getClusterConfigCmd = &cobra.Command{
 	Short: "display the cluster operational config",
 	Long: `The 'get cluster-config' displays the cluster operational config for a
 cluster using the current context or a cluster specified by using '-c'. Only available
-in most recent Coherence versions`,
+in most recent Coherence versions. Use '-M' to display the cache mappings and schemes from
+the cache configuration if it is included in the cluster config.`,
 	Args: cobra.ExactArgs(0),
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		var (
@@ -1092,6 +1144,15 @@ in most recent Coherence versions`,
 			return err
 		}
 
+		if showCacheMappings {
+			config, err := parseCacheConfig(data)
+			if err != nil {
+				return err
+			}
+			cmd.Println(FormatCacheMappings(config))
+			return nil
+		}
+
 		cmd.Println(string(data))
 
 		return nil
@@ -1423,6 +1484,19 @@ func runClusterOperation(cmd *cobra.Command, connectionName, operation string) e
 	// retrieve the slice of running PIDS
 	processIDs = getRunningProcesses(dataFetcher)
 
+	// include any tracked processes which are not visible, as management may not be available
+	trackedProcessIDs := getRunningClusterProcessIDs(connection.Name)
+	if operation == stopClusterCommand {
+		for _, v := range trackedProcessIDs {
+			if !slices.Contains(processIDs, v) {
+				processIDs = append(processIDs, v)
+			}
+		}
+	} else if operation == startClusterCommand && len(processIDs) == 0 && len(trackedProcessIDs) > 0 {
+		return fmt.Errorf("the cluster %s has running processes %v which are not visible using management, please stop the cluster first",
+			connection.Name, trackedProcessIDs)
+	}
+
 	numProcesses := int32(len(processIDs))
 
 	if (operation == stopClusterCommand || operation == scaleClusterCommand) && numProcesses == 0 {
@@ -1450,6 +1524,9 @@ func runClusterOperation(cmd *cobra.Command, connectionName, operation string) e
 	}
 
 	if operation == scaleClusterCommand {
+		if len(connection.Topology) > 0 {
+			return fmt.Errorf("the cluster %s was created from a topology file and cannot be scaled", connection.Name)
+		}
 		if replicaCountParam < 1 {
 			return errors.New("replicas must be a positive value")
 		} else if replicaCountParam == numProcesses {
@@ -1489,6 +1566,11 @@ func runClusterOperation(cmd *cobra.Command, connectionName, operation string) e
 	}
 
 	if operation == stopClusterCommand {
+		// untrack the processes first so that a supervisor does not restart the members
+		if err = untrackMemberProcesses(connection.Name); err != nil {
+			return err
+		}
+
 		count := 0
 		for _, v := range processIDs {
 			proc, err = os.FindProcess(v)
@@ -1525,7 +1607,11 @@ func runClusterOperation(cmd *cobra.Command, connectionName, operation string) e
 func init() {
 	addClusterCmd.Flags().StringVarP(&connectionURL, "url", "u", "", "connection URL")
 	_ = addClusterCmd.MarkFlagRequired("url")
-	addClusterCmd.Flags().StringVarP(&connectionType, "type", "t", httpType, "connection type, http")
+	addClusterCmd.Flags().StringVarP(&connectionType, "type", "t", httpType, "connection type, http or replay")
+	addClusterCmd.Flags().StringVarP(&addClusterCredential, "credential", "C", "", "credential to use for the connection")
+	addTLSFlags(addClusterCmd)
+
+	getClusterConfigCmd.Flags().BoolVarP(&showCacheMappings, "cache-mappings", "M", false, "display the cache mappings and schemes")
 
 	describeClusterCmd.Flags().BoolVarP(&verboseOutput, "verbose", "v", false,
 		"include verbose output including individual members, reporters and executor details")
@@ -1567,6 +1653,7 @@ func init() {
 	createClusterCmd.Flags().StringVarP(&roleParam, roleArg, "", "", roleMessage)
 	createClusterCmd.Flags().StringVarP(&settingsFileParam, "maven-settings", "", "", "full path to Maven settings file")
 	createClusterCmd.Flags().BoolVarP(&dynamicHTTPParam, "dynamic-http", "N", false, dynamicHTTPMessage)
+	createClusterCmd.Flags().StringVarP(&topologyFileParam, topologyArg, "f", "", topologyMessage)
 
 	stopClusterCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
 
@@ -0,0 +1,372 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/constants"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	diffCategoryService    = "service"
+	diffCategoryCache      = "cache"
+	diffCategoryMembers    = "members"
+	diffCategoryFederation = "federation"
+	diffMissing            = "<missing>"
+	diffPresent            = "present"
+)
+
+var (
+	backupCountPattern = regexp.MustCompile(`BackupCount=(\d+)`)
+
+	// cacheConfigAttributes are the cache attributes compared, the description contains
+	// the backing map implementation and eviction policy.
+	cacheConfigAttributes = []string{"memoryUnits", "unitFactor", "highUnits", "lowUnits", "expiryDelay",
+		"batchFactor", "refreshFactor", "requeueThreshold", "description"}
+)
+
+// clusterDiffSource contains the details of a cluster or bundle used for comparison.
+type clusterDiffSource struct {
+	services     []config.ServiceSummary
+	descriptions map[string]string
+	caches       []config.CacheSummaryDetail
+	cacheConfigs map[string]map[string]string
+	members      []config.Member
+	federation   []config.FederationSummary
+}
+
+// ClusterDifference describes a single compared attribute between two sources.
+type ClusterDifference struct {
+	Category  string `json:"category"`
+	Name      string `json:"name"`
+	Attribute string `json:"attribute"`
+	Source1   string `json:"source1"`
+	Source2   string `json:"source2"`
+	Match     bool   `json:"match"`
+}
+
+// ClusterDifferences contains the result of comparing two sources.
+type ClusterDifferences struct {
+	Source1     string              `json:"source1"`
+	Source2     string              `json:"source2"`
+	Differences []ClusterDifference `json:"items"`
+}
+
+// diffClustersCmd represents the diff clusters command.
+var diffClustersCmd = &cobra.Command{
+	Use:   "clusters source1 source2",
+	Short: "compare the configuration of two clusters or diagnostic bundles",
+	Long: `The 'diff clusters' command compares two sources, where each source is either a
+cluster connection or a bundle file created using 'create diagnostic-bundle'. Differences in
+services, caches, cache configuration such as units, expiry and the backing map, members,
+partition counts, backup counts, persistence modes and federation participants are displayed. Specify '-o wide' to also display attributes that match.`,
+	ValidArgsFunction: completionAllClusters,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			displayErrorAndExit(cmd, "you must provide two cluster connections or bundle files")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var sources = make([]clusterDiffSource, 2)
+
+		if err := checkOutputFormat(); err != nil {
+			return err
+		}
+
+		for i, arg := range args {
+			source, err := loadClusterDiffSource(arg)
+			if err != nil {
+				return err
+			}
+			sources[i] = source
+		}
+
+		differences := ClusterDifferences{Source1: args[0], Source2: args[1],
+			Differences: compareClusterSources(sources[0], sources[1])}
+
+		if isJSONPathOrJSON() {
+			jsonData, err := json.Marshal(differences)
+			if err != nil {
+				return err
+			}
+			return processJSONOutput(cmd, jsonData)
+		}
+
+		cmd.Println(FormatClusterDifferences(differences, OutputFormat == constants.WIDE))
+		return nil
+	},
+}
+
+// loadClusterDiffSource loads a source from a bundle file, if the file exists, or from a cluster connection.
+func loadClusterDiffSource(source string) (clusterDiffSource, error) {
+	if utils.FileExists(source) {
+		bundle, err := loadDiagnosticBundle(source)
+		if err != nil {
+			return clusterDiffSource{}, err
+		}
+		return newClusterDiffSource(bundle.getEntry)
+	}
+
+	dataFetcher, err := GetDataFetcher(source)
+	if err != nil {
+		return clusterDiffSource{}, err
+	}
+
+	return newClusterDiffSource(func(name string) ([]byte, error) {
+		for _, collector := range getBundleCollectors() {
+			if collector.name == name {
+				return collector.collect(dataFetcher)
+			}
+		}
+		return nil, fmt.Errorf("unknown entry %s", name)
+	})
+}
+
+// newClusterDiffSource creates a new source using the provided function to retrieve bundle entries.
+func newClusterDiffSource(getEntry func(string) ([]byte, error)) (clusterDiffSource, error) {
+	var (
+		source     = clusterDiffSource{descriptions: make(map[string]string), cacheConfigs: make(map[string]map[string]string)}
+		services   = config.ServicesSummaries{}
+		caches     = config.CacheSummaries{}
+		members    = config.Members{}
+		federation = config.FederationSummaries{}
+	)
+
+	if err := unmarshalDiffEntry(getEntry, bundleServices, &services); err != nil {
+		return source, err
+	}
+	// service descriptions are only used for backup counts so are not required
+	_ = unmarshalDiffEntry(getEntry, bundleServiceDescriptions, &source.descriptions)
+	if err := unmarshalDiffEntry(getEntry, bundleCaches, &caches); err != nil {
+		return source, err
+	}
+	// cache configs are not present in older bundles so are not required
+	_ = unmarshalDiffEntry(getEntry, bundleCacheConfigs, &source.cacheConfigs)
+	if err := unmarshalDiffEntry(getEntry, bundleMembers, &members); err != nil {
+		return source, err
+	}
+	if err := unmarshalDiffEntry(getEntry, bundleFederationOutgoing, &federation); err != nil {
+		return source, err
+	}
+
+	source.services = DeduplicateServices(services, all)
+	source.caches = caches.Caches
+	source.members = members.Members
+	source.federation = federation.Services
+
+	return source, nil
+}
+
+// unmarshalDiffEntry retrieves and unmarshals an entry. An empty entry is ignored.
+func unmarshalDiffEntry(getEntry func(string) ([]byte, error), name string, v interface{}) error {
+	data, err := getEntry(name)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if err = json.Unmarshal(data, v); err != nil {
+		return utils.GetError("unable to unmarshall "+name, err)
+	}
+	return nil
+}
+
+// compareClusterSources compares two sources and returns all the compared attributes.
+func compareClusterSources(source1, source2 clusterDiffSource) []ClusterDifference {
+	var differences = make([]ClusterDifference, 0)
+
+	add := func(category, name, attribute, value1, value2 string) {
+		differences = append(differences, ClusterDifference{Category: category, Name: name,
+			Attribute: attribute, Source1: value1, Source2: value2, Match: value1 == value2})
+	}
+
+	// services
+	services1 := getDiffServiceMap(source1)
+	services2 := getDiffServiceMap(source2)
+	for _, name := range getUnionOfKeys(services1, services2) {
+		value1, ok1 := services1[name]
+		value2, ok2 := services2[name]
+		if !ok1 || !ok2 {
+			add(diffCategoryService, name, "exists", getPresence(ok1), getPresence(ok2))
+			continue
+		}
+		for _, attribute := range []string{"type", "partitionCount", "backupCount", "persistenceMode", "storageEnabledCount"} {
+			add(diffCategoryService, name, attribute, value1[attribute], value2[attribute])
+		}
+	}
+
+	// caches
+	caches1 := getDiffCacheMap(source1)
+	caches2 := getDiffCacheMap(source2)
+	for _, name := range getUnionOfKeys(caches1, caches2) {
+		value1, ok1 := caches1[name]
+		value2, ok2 := caches2[name]
+		if !ok1 || !ok2 {
+			add(diffCategoryCache, name, "exists", getPresence(ok1), getPresence(ok2))
+			continue
+		}
+		for _, attribute := range getUnionOfKeys(value1, value2) {
+			add(diffCategoryCache, name, attribute, getValueOrMissing(value1, attribute), getValueOrMissing(value2, attribute))
+		}
+	}
+
+	// members
+	members1 := getDiffMemberMap(source1)
+	members2 := getDiffMemberMap(source2)
+	for _, name := range getUnionOfKeys(members1, members2) {
+		add(diffCategoryMembers, name, "count", getValueOrMissing(members1, name), getValueOrMissing(members2, name))
+	}
+
+	// federation participants
+	federation1 := getDiffFederationMap(source1)
+	federation2 := getDiffFederationMap(source2)
+	for _, name := range getUnionOfKeys(federation1, federation2) {
+		add(diffCategoryFederation, name, "participants", getValueOrMissing(federation1, name), getValueOrMissing(federation2, name))
+	}
+
+	return differences
+}
+
+// getDiffServiceMap returns the compared attributes for each service keyed by service name.
+func getDiffServiceMap(source clusterDiffSource) map[string]map[string]string {
+	var result = make(map[string]map[string]string)
+
+	for _, service := range source.services {
+		backupCount := na
+		if matches := backupCountPattern.FindStringSubmatch(source.descriptions[service.ServiceName]); len(matches) > 1 {
+			backupCount = matches[1]
+		}
+		persistenceMode := service.PersistenceMode
+		if persistenceMode == "" {
+			persistenceMode = na
+		}
+		result[service.ServiceName] = map[string]string{
+			"type":                service.ServiceType,
+			"partitionCount":      fmt.Sprintf("%d", service.PartitionsAll),
+			"backupCount":         backupCount,
+			"persistenceMode":     persistenceMode,
+			"storageEnabledCount": fmt.Sprintf("%d", service.StorageEnabledCount),
+		}
+	}
+
+	return result
+}
+
+// getDiffCacheMap returns the configuration attributes for each cache keyed by service/cache.
+func getDiffCacheMap(source clusterDiffSource) map[string]map[string]string {
+	var result = make(map[string]map[string]string)
+	for _, cache := range source.caches {
+		key := cache.ServiceName + "/" + cache.CacheName
+		attributes := make(map[string]string)
+		for attribute, value := range source.cacheConfigs[key] {
+			attributes[attribute] = value
+		}
+		result[key] = attributes
+	}
+	return result
+}
+
+// getDiffMemberMap returns the total and storage-enabled member counts, the member count
+// for each role and the number of distinct machines, racks and sites.
+func getDiffMemberMap(source clusterDiffSource) map[string]string {
+	var (
+		counts         = make(map[string]int)
+		result         = make(map[string]string)
+		storageEnabled = 0
+	)
+
+	for _, member := range source.members {
+		counts["role="+member.RoleName]++
+		if member.StorageEnabled {
+			storageEnabled++
+		}
+	}
+
+	for key, count := range counts {
+		result[key] = fmt.Sprintf("%d", count)
+	}
+
+	result["total"] = fmt.Sprintf("%d", len(source.members))
+	result["storageEnabled"] = fmt.Sprintf("%d", storageEnabled)
+	result["machines"] = fmt.Sprintf("%d", countDistinct(source.members, func(m config.Member) string { return m.MachineName }))
+	result["racks"] = fmt.Sprintf("%d", countDistinct(source.members, func(m config.Member) string { return m.RackName }))
+	result["sites"] = fmt.Sprintf("%d", countDistinct(source.members, func(m config.Member) string { return m.SiteName }))
+
+	return result
+}
+
+// getDiffFederationMap returns the sorted participants keyed by federated service.
+func getDiffFederationMap(source clusterDiffSource) map[string]string {
+	var (
+		participants = make(map[string][]string)
+		result       = make(map[string]string)
+	)
+
+	for _, summary := range source.federation {
+		if !utils.SliceContains(participants[summary.ServiceName], summary.ParticipantName) {
+			participants[summary.ServiceName] = append(participants[summary.ServiceName], summary.ParticipantName)
+		}
+	}
+
+	for service, values := range participants {
+		sort.Strings(values)
+		result[service] = strings.Join(values, ",")
+	}
+
+	return result
+}
+
+// countDistinct returns the number of distinct non-empty values for members.
+func countDistinct(members []config.Member, value func(config.Member) string) int {
+	var distinct = make(map[string]bool)
+	for _, member := range members {
+		if v := value(member); v != "" {
+			distinct[v] = true
+		}
+	}
+	return len(distinct)
+}
+
+// getUnionOfKeys returns the sorted union of the keys of two maps.
+func getUnionOfKeys[V any](map1, map2 map[string]V) []string {
+	var keys = make([]string, 0, len(map1)+len(map2))
+	for k := range map1 {
+		keys = append(keys, k)
+	}
+	for k := range map2 {
+		if _, ok := map1[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getValueOrMissing returns the value from the map or diffMissing if not present.
+func getValueOrMissing(values map[string]string, key string) string {
+	if value, ok := values[key]; ok {
+		return value
+	}
+	return diffMissing
+}
+
+// getPresence returns a string indicating if an item is present.
+func getPresence(present bool) string {
+	if present {
+		return diffPresent
+	}
+	return diffMissing
+}
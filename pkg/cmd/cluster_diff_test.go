@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompareClusterSources(t *testing.T) {
+	var (
+		g       = gomega.NewGomegaWithT(t)
+		source1 = clusterDiffSource{
+			services: []config.ServiceSummary{
+				{ServiceName: "PartitionedCache", ServiceType: "DistributedCache", PartitionsAll: 257, PersistenceMode: "active"},
+				{ServiceName: "Proxy", ServiceType: "Proxy"},
+			},
+			descriptions: map[string]string{"PartitionedCache": "PartitionedCache{PartitionCount=257, BackupCount=1}"},
+			caches:       []config.CacheSummaryDetail{{ServiceName: "PartitionedCache", CacheName: "test"}},
+			cacheConfigs: map[string]map[string]string{"PartitionedCache/test": {"highUnits": "1000", "expiryDelay": "0"}},
+			members:      []config.Member{{RoleName: "storage", MachineName: "m1"}, {RoleName: "storage", MachineName: "m2"}},
+			federation:   []config.FederationSummary{{ServiceName: "Federated", ParticipantName: "B"}, {ServiceName: "Federated", ParticipantName: "A"}},
+		}
+		source2 = clusterDiffSource{
+			services: []config.ServiceSummary{
+				{ServiceName: "PartitionedCache", ServiceType: "DistributedCache", PartitionsAll: 257, PersistenceMode: "on-demand"},
+			},
+			descriptions: map[string]string{"PartitionedCache": "PartitionedCache{PartitionCount=257, BackupCount=2}"},
+			caches:       []config.CacheSummaryDetail{{ServiceName: "PartitionedCache", CacheName: "test"}},
+			cacheConfigs: map[string]map[string]string{"PartitionedCache/test": {"highUnits": "2000", "expiryDelay": "0"}},
+			members:      []config.Member{{RoleName: "storage", MachineName: "m1", StorageEnabled: true}, {RoleName: "storage", MachineName: "m2"}},
+			federation:   []config.FederationSummary{{ServiceName: "Federated", ParticipantName: "A"}},
+		}
+	)
+
+	differences := compareClusterSources(source1, source2)
+	mismatches := make(map[string]ClusterDifference)
+	for _, d := range differences {
+		if !d.Match {
+			mismatches[d.Category+"/"+d.Name+"/"+d.Attribute] = d
+		}
+	}
+
+	g.Expect(len(mismatches)).To(gomega.Equal(6))
+	g.Expect(mismatches["cache/PartitionedCache/test/highUnits"].Source1).To(gomega.Equal("1000"))
+	g.Expect(mismatches["cache/PartitionedCache/test/highUnits"].Source2).To(gomega.Equal("2000"))
+	g.Expect(mismatches["members/storageEnabled/count"].Source2).To(gomega.Equal("1"))
+	g.Expect(mismatches["service/Proxy/exists"].Source2).To(gomega.Equal(diffMissing))
+	g.Expect(mismatches["service/PartitionedCache/backupCount"].Source1).To(gomega.Equal("1"))
+	g.Expect(mismatches["service/PartitionedCache/backupCount"].Source2).To(gomega.Equal("2"))
+	g.Expect(mismatches["service/PartitionedCache/persistenceMode"].Source2).To(gomega.Equal("on-demand"))
+	g.Expect(mismatches["federation/Federated/participants"].Source1).To(gomega.Equal("A,B"))
+
+	g.Expect(FormatClusterDifferences(ClusterDifferences{Source1: "a", Source2: "b",
+		Differences: compareClusterSources(source2, source2)}, false)).To(gomega.Equal("No differences found"))
+}
+
+func TestDiagnosticBundleRoundTrip(t *testing.T) {
+	var (
+		g          = gomega.NewGomegaWithT(t)
+		bundleFile = filepath.Join(t.TempDir(), "bundle.tar.gz")
+		manifest   = diagnosticBundleManifest{Connection: "local", Entries: []diagnosticBundleEntry{
+			{Name: bundleMembers, File: "members.json", Collected: "2026-10-17T10:11:12Z"},
+			{Name: bundleTopics, File: "topics.json", Error: "failed"},
+		}}
+		contents = map[string][]byte{"members.json": []byte(`{"items":[]}`)}
+	)
+
+	g.Expect(writeDiagnosticBundle(bundleFile, "bundle", manifest, contents)).To(gomega.Succeed())
+
+	bundle, err := loadDiagnosticBundle(bundleFile)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(bundle.manifest.Connection).To(gomega.Equal("local"))
+
+	data, err := bundle.getEntry(bundleMembers)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.Equal(`{"items":[]}`))
+
+	_, err = bundle.getEntry(bundleTopics)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2022, 2025 Oracle and/or its affiliates.
+ * Copyright (c) 2022, 2026 Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * https://oss.oracle.com/licenses/upl.
  */
@@ -8,6 +8,7 @@ package cmd
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"github.com/oracle/coherence-cli/pkg/config"
 	"github.com/oracle/coherence-cli/pkg/fetcher"
@@ -229,12 +230,47 @@ func updateDefaultJars() {
 }
 
 // startCluster starts a cluster. If existingCount > 1 then this means we are
-// scaling a cluster, otherwise we are starting one.
+// scaling a cluster, otherwise we are starting one. If the cluster was created
+// from a topology file, then the members for each group in the topology are started.
 func startCluster(cmd *cobra.Command, connection ClusterConnection, serverCount, existingCount int32) error {
+	return startClusterMembers(cmd, connection, serverCount, existingCount, "")
+}
+
+// restartClusterMember starts an individual member of a cluster of serverCount members,
+// using the same ports and arguments the member would have been started with.
+func restartClusterMember(cmd *cobra.Command, connection ClusterConnection, serverCount int32, member string) error {
+	return startClusterMembers(cmd, connection, serverCount, 0, member)
+}
+
+// getClusterMemberNames returns the names of the members in the order they are started for a
+// cluster of serverCount members. The first member is the one with management over REST enabled.
+func getClusterMemberNames(connection ClusterConnection, serverCount int32) []string {
+	var names = make([]string, 0)
+	for _, m := range getClusterMembersToStart(connection, serverCount, 0) {
+		names = append(names, m.name)
+	}
+	return names
+}
+
+// getClusterMembersToStart returns the members to start for a cluster.
+func getClusterMembersToStart(connection ClusterConnection, serverCount, existingCount int32) []topologyMember {
+	if len(connection.Topology) > 0 {
+		return getTopologyMembers(connection.Topology)
+	}
+
+	members := make([]topologyMember, 0)
+	for counter := existingCount; counter < serverCount+existingCount; counter++ {
+		members = append(members, topologyMember{name: fmt.Sprintf("storage-%d", counter)})
+	}
+	return members
+}
+
+// startClusterMembers starts the members of a cluster, or only the member named onlyMember if it is set.
+func startClusterMembers(cmd *cobra.Command, connection ClusterConnection, serverCount, existingCount int32, onlyMember string) error {
 	var (
 		err              error
 		mgmtPort         = connection.ManagementPort
-		counter          int32
+		memberFound      bool
 		metricsStartPort = metricsStartPortParam
 		healthStartPort  = healthStartPortParam
 		startupProfile   = getProfileValue(profileValueParam)
@@ -269,9 +305,9 @@ func startCluster(cmd *cobra.Command, connection ClusterConnection, serverCount,
 		}
 	}
 
-	for counter = existingCount; counter < serverCount+existingCount; counter++ {
+	for _, m := range getClusterMembersToStart(connection, serverCount, existingCount) {
 		var (
-			member        = fmt.Sprintf("storage-%d", counter)
+			member        = m.name
 			arguments     = getCommonArguments(connection)
 			memberLogFile string
 		)
@@ -294,7 +330,7 @@ func startCluster(cmd *cobra.Command, connection ClusterConnection, serverCount,
 			healthStartPort++
 		}
 
-		arguments = append(arguments, getCacheServerArgs(connection, member, mgmtPort, connection.ClusterVersion)...)
+		arguments = append(arguments, getCacheServerArgs(connection, member, mgmtPort, connection.ClusterVersion, m.group)...)
 
 		// reset so only first member has management enabled
 		if dynamicHTTPParam {
@@ -303,6 +339,12 @@ func startCluster(cmd *cobra.Command, connection ClusterConnection, serverCount,
 			mgmtPort = -1
 		}
 
+		// the ports and arguments are still calculated for other members, so they are consistent
+		if onlyMember != "" && member != onlyMember {
+			continue
+		}
+		memberFound = true
+
 		memberLogFile, err = getLogFile(connection.Name, member)
 		if err != nil {
 			return err
@@ -323,16 +365,25 @@ func startCluster(cmd *cobra.Command, connection ClusterConnection, serverCount,
 		}
 
 		cmd.Printf("Starting cluster member %s...\n", member)
-		_, err = runCommandAsync(javaExec, memberLogFile, arguments)
+		pid, err := runCommandAsync(javaExec, memberLogFile, arguments)
 		if err != nil {
 			return utils.GetError(fmt.Sprintf("unable to start member %s", member), err)
 		}
 
+		// track the process so it can be displayed, stopped or restarted when management is not available
+		if err = trackMemberProcess(connection.Name, member, pid, memberLogFile, arguments, 0); err != nil {
+			cmd.Printf("unable to track process for member %s: %v\n", member, err)
+		}
+
 		if startupDelay > 0 {
 			time.Sleep(time.Duration(startupDelay) * time.Millisecond)
 		}
 	}
 
+	if onlyMember != "" && !memberFound {
+		return fmt.Errorf("unable to find member %s in cluster %s", onlyMember, connection.Name)
+	}
+
 	return nil
 }
 
@@ -390,13 +441,23 @@ func startClient(cmd *cobra.Command, connection ClusterConnection, class string,
 	return process.Wait()
 }
 
-func getCacheServerArgs(connection ClusterConnection, member string, httpPort int32, version string) []string {
+// getCacheServerArgs returns the arguments to start a cache server. If group is not nil
+// then the machine, rack, site, role and heap from the member group are used.
+func getCacheServerArgs(connection ClusterConnection, member string, httpPort int32, version string, group *MemberGroup) []string {
 	var (
 		baseArgs  = make([]string, 0)
 		heap      string
 		mainClass = connection.StartupClass
+		machine   = machineParam
+		rack      = rackParam
+		site      = siteParam
+		role      = roleParam
 	)
 
+	if group != nil {
+		machine, rack, site, role = group.Machine, group.Rack, group.Site, group.Role
+	}
+
 	// override only if we specify the class
 	if serverStartClassParam != "" || connection.StartupClass == "" {
 		mainClass = serverStartClassParam
@@ -419,24 +480,26 @@ func getCacheServerArgs(connection ClusterConnection, member string, httpPort in
 		}
 	}
 
-	if machineParam != "" {
-		baseArgs = append(baseArgs, fmt.Sprintf("-Dcoherence.machine=%s", machineParam))
+	if machine != "" {
+		baseArgs = append(baseArgs, fmt.Sprintf("-Dcoherence.machine=%s", machine))
 	}
 
-	if rackParam != "" {
-		baseArgs = append(baseArgs, fmt.Sprintf("-Dcoherence.rack=%s", rackParam))
+	if rack != "" {
+		baseArgs = append(baseArgs, fmt.Sprintf("-Dcoherence.rack=%s", rack))
 	}
 
-	if siteParam != "" {
-		baseArgs = append(baseArgs, fmt.Sprintf("-Dcoherence.site=%s", siteParam))
+	if site != "" {
+		baseArgs = append(baseArgs, fmt.Sprintf("-Dcoherence.site=%s", site))
 	}
 
-	if roleParam != "" {
-		baseArgs = append(baseArgs, fmt.Sprintf("-Dcoherence.role=%s", roleParam))
+	if role != "" {
+		baseArgs = append(baseArgs, fmt.Sprintf("-Dcoherence.role=%s", role))
 	}
 
-	// if default heap is overridden, then use this
-	if heapMemoryParam != defaultHeap {
+	// if the member group specifies a heap or default heap is overridden, then use this
+	if group != nil && group.Heap != "" {
+		heap = group.Heap
+	} else if heapMemoryParam != defaultHeap {
 		heap = heapMemoryParam
 	} else {
 		// if the default-heap is set in config then use this
@@ -454,7 +517,14 @@ func getCacheServerArgs(connection ClusterConnection, member string, httpPort in
 		mainClass = utils.GetCoherenceMainClass(version)
 	}
 
-	return append(baseArgs, getMemberProperty(member), mainClass)
+	baseArgs = append(baseArgs, getMemberProperty(member))
+
+	// the group arguments are added last, before the main class, so they override any cluster level values
+	if group != nil {
+		baseArgs = append(baseArgs, getMemberGroupArgs(group)...)
+	}
+
+	return append(baseArgs, mainClass)
 }
 
 // getClientArgs returns the arguments for starting a Coherence process such as
@@ -508,11 +578,25 @@ func getOperationalOverrideConfigProperty() string {
 	return fmt.Sprintf(" -Dcoherence.override=%s", operationalConfigParam)
 }
 
-func validateOverrideParams() error {
+// validateOverrideParams validates the cache config and override files. A cache config file that
+// cannot be parsed is rejected, but any other issues are only displayed as warnings as the checks
+// may not cover every valid configuration.
+func validateOverrideParams(cmd *cobra.Command) error {
 	if cacheConfigParam != "" {
 		if !isRegularFile(cacheConfigParam) {
 			return fmt.Errorf("cahce config file %s does not exist", cacheConfigParam)
 		}
+		data, err := os.ReadFile(cacheConfigParam)
+		if err != nil {
+			return err
+		}
+		if err = xml.Unmarshal(data, &cacheConfigNode{}); err != nil {
+			return utils.GetError("unable to parse cache config file "+cacheConfigParam, err)
+		}
+		if errorCount := getCacheConfigErrorCount(validateCacheConfig(data)); errorCount > 0 {
+			cmd.Printf("WARNING: cache config file %s has %d issue(s), use 'cohctl validate cache-config %s' to display them\n",
+				cacheConfigParam, errorCount, cacheConfigParam)
+		}
 	}
 	if operationalConfigParam != "" {
 		if !isRegularFile(operationalConfigParam) {
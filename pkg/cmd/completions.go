@@ -49,6 +49,24 @@ func completionAllPanels(_ *cobra.Command, _ []string, _ string) ([]string, cobr
 	return panels, cobra.ShellCompDirectiveNoFileComp
 }
 
+// completionAllRules provides a completion function to return all rules.
+func completionAllRules(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	rules := make([]string, 0)
+	for _, r := range Config.Rules {
+		rules = append(rules, r.Name)
+	}
+	return rules, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionAllCredentials provides a completion function to return all credentials.
+func completionAllCredentials(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	credentials := make([]string, 0)
+	for _, c := range Config.Credentials {
+		credentials = append(credentials, c.Name)
+	}
+	return credentials, cobra.ShellCompDirectiveNoFileComp
+}
+
 // completionCaches provides a completion function to return all cache names.
 func completionCaches(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 	var (
@@ -0,0 +1,597 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/term"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	provideCredentialName = "you must provide a single credential name"
+	credentialTypeBasic   = "basic"
+	credentialTypeToken   = "token"
+	credentialTypeCert    = "cert"
+	credentialsStoreFile  = "credentials.enc"
+	credentialsKeyFile    = "credentials.key"
+	credentialsKeyEnv     = "COHCTL_CREDENTIALS_KEY"
+	credentialSourceStore = "store"
+	credentialSourceCmd   = "command"
+	credentialNone        = "none"
+	credentialKeyLength   = 32
+	credentialSaltLength  = 16
+	credentialFilePerms   = 0600
+
+	// scrypt parameters used to derive the key from COHCTL_CREDENTIALS_KEY
+	credentialScryptN = 32768
+	credentialScryptR = 8
+	credentialScryptP = 1
+)
+
+var (
+	credentialType       string
+	credentialCommand    string
+	credentialClientCert string
+	credentialClientKey  string
+	connectionCredential string
+
+	validCredentialTypes = []string{credentialTypeBasic, credentialTypeToken, credentialTypeCert}
+)
+
+// addCredentialCmd represents the add credential command.
+var addCredentialCmd = &cobra.Command{
+	Use:   "credential credential-name",
+	Short: "add a credential that can be used by cluster connections",
+	Long: `The 'add credential' command adds a credential that can be referenced by one or more
+cluster connections. The type can be 'basic' for basic authentication using '-U' for the username,
+'token' for a bearer token or 'cert' for a client certificate and key specified using '--client-cert'
+and '--client-key'. Passwords and tokens are prompted for, or read from stdin using '-i', and are
+stored in an encrypted file in the config directory. Alternatively, specify '--command' to retrieve
+the secret from an external command, such as 'pass show coherence/prod', each time it is used.
+NOTE: Unless the COHCTL_CREDENTIALS_KEY environment variable is set to a passphrase, the encryption
+key is stored in the config directory next to the encrypted file, so this is only obfuscation and
+anyone who can read the config directory can read the secrets.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, provideCredentialName)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			credentialName = args[0]
+			err            error
+			secret         string
+			credential     = Credential{Name: credentialName, Type: credentialType, Username: Username,
+				Command: credentialCommand, ClientCert: credentialClientCert, ClientKey: credentialClientKey}
+		)
+
+		if err = validateName("credential", credentialName); err != nil {
+			return err
+		}
+
+		if _, found := getCredential(credentialName); found {
+			return fmt.Errorf("the credential '%s' already exists", credentialName)
+		}
+
+		if err = validateCredential(credential); err != nil {
+			return err
+		}
+
+		// only prompt for the secret if it is stored rather than retrieved via a command
+		if getCredentialSource(credential) == credentialSourceStore {
+			if secret, err = readCredentialSecret(cmd, credential.Type); err != nil {
+				return err
+			}
+		}
+
+		if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to add the %s credential %s? (y/n) ", credential.Type, credentialName)) {
+			return nil
+		}
+
+		if secret != "" {
+			if err = setCredentialSecret(credentialName, secret); err != nil {
+				return err
+			}
+		}
+
+		viper.Set(credentialsKey, append(Config.Credentials, credential))
+		if err = WriteConfig(); err != nil {
+			return err
+		}
+
+		cmd.Printf("credential %s was added\n", credentialName)
+		return nil
+	},
+}
+
+// removeCredentialCmd represents the remove credential command.
+var removeCredentialCmd = &cobra.Command{
+	Use:   "credential credential-name",
+	Short: "remove a credential",
+	Long: `The 'remove credential' command removes a credential and any stored secret.
+The credential cannot be removed if it is used by a cluster connection.`,
+	ValidArgsFunction: completionAllCredentials,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, provideCredentialName)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			credentialName = args[0]
+			err            error
+			newCredentials = make([]Credential, 0)
+		)
+
+		if _, found := getCredential(credentialName); !found {
+			return fmt.Errorf("a credential with the name %s does not exist", credentialName)
+		}
+
+		for _, c := range Config.Clusters {
+			if c.Credential == credentialName {
+				return fmt.Errorf("the credential %s is used by cluster connection %s", credentialName, c.Name)
+			}
+		}
+
+		if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to remove the credential %s? (y/n) ", credentialName)) {
+			return nil
+		}
+
+		if err = setCredentialSecret(credentialName, ""); err != nil {
+			return err
+		}
+
+		for _, c := range Config.Credentials {
+			if c.Name != credentialName {
+				newCredentials = append(newCredentials, c)
+			}
+		}
+
+		viper.Set(credentialsKey, newCredentials)
+		if err = WriteConfig(); err != nil {
+			return err
+		}
+
+		cmd.Printf("credential %s was removed\n", credentialName)
+		return nil
+	},
+}
+
+// getCredentialsCmd represents the get credentials command.
+var getCredentialsCmd = &cobra.Command{
+	Use:   "credentials",
+	Short: "display the credentials that have been added",
+	Long: `The 'get credentials' command displays the credentials that have been added
+and the cluster connections that use them. Secrets are never displayed.`,
+	Args: cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cmd.Println(FormatCredentials(Config.Credentials))
+		return nil
+	},
+}
+
+// setCredentialCmd represents the set credential command.
+var setCredentialCmd = &cobra.Command{
+	Use:   "credential connection-name",
+	Short: "set the credential for a cluster connection",
+	Long: `The 'set credential' command sets the credential used by a cluster connection.
+Specify 'none' to remove the credential from the connection. If the '-U' option is
+specified when running a command, the credential is ignored.`,
+	ValidArgsFunction: completionAllClusters,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, youMustProviderConnectionMessage)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			connectionName = args[0]
+			newCredential  = connectionCredential
+			index          = -1
+		)
+
+		for i, c := range Config.Clusters {
+			if c.Name == connectionName {
+				index = i
+				break
+			}
+		}
+
+		if index == -1 {
+			return errors.New(UnableToFindClusterMsg + connectionName)
+		}
+
+		if newCredential == credentialNone {
+			newCredential = ""
+		} else if _, found := getCredential(newCredential); !found {
+			return fmt.Errorf("a credential with the name %s does not exist", newCredential)
+		}
+
+		if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to set the credential for connection %s to %s? (y/n) ", connectionName, connectionCredential)) {
+			return nil
+		}
+
+		Config.Clusters[index].Credential = newCredential
+
+		viper.Set(clusterKey, Config.Clusters)
+		if err := WriteConfig(); err != nil {
+			return err
+		}
+
+		if newCredential == "" {
+			cmd.Printf("credential for connection %s was removed\n", connectionName)
+		} else {
+			cmd.Printf("credential for connection %s was set to %s\n", connectionName, newCredential)
+		}
+		return nil
+	},
+}
+
+func init() {
+	addCredentialCmd.Flags().StringVarP(&credentialType, "type", "t", credentialTypeBasic, "credential type, basic, token or cert")
+	addCredentialCmd.Flags().StringVarP(&credentialCommand, "command", "", "", "external command to retrieve the password or token from")
+	addCredentialCmd.Flags().StringVarP(&credentialClientCert, "client-cert", "", "", "client certificate file for cert credentials")
+	addCredentialCmd.Flags().StringVarP(&credentialClientKey, "client-key", "", "", "client key file for cert credentials")
+	addCredentialCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
+
+	removeCredentialCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
+
+	setCredentialCmd.Flags().StringVarP(&connectionCredential, "credential", "C", "", "credential name, or 'none' to remove")
+	_ = setCredentialCmd.MarkFlagRequired("credential")
+	setCredentialCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
+}
+
+// validateCredential validates the type and the values required for the type.
+func validateCredential(credential Credential) error {
+	if !utils.SliceContains(validCredentialTypes, credential.Type) {
+		return fmt.Errorf("invalid credential type %s, must be one of %v", credential.Type, validCredentialTypes)
+	}
+
+	switch credential.Type {
+	case credentialTypeBasic:
+		if credential.Username == "" {
+			return errors.New("you must provide a username using -U for basic credentials")
+		}
+	case credentialTypeCert:
+		if credential.ClientCert == "" || credential.ClientKey == "" {
+			return errors.New("you must provide --client-cert and --client-key for cert credentials")
+		}
+		if credential.Command != "" {
+			return errors.New("you cannot specify --command for cert credentials")
+		}
+		if _, err := tls.LoadX509KeyPair(credential.ClientCert, credential.ClientKey); err != nil {
+			return utils.GetError("unable to load client certificate and key", err)
+		}
+	}
+
+	if credential.Type != credentialTypeCert && (credential.ClientCert != "" || credential.ClientKey != "") {
+		return fmt.Errorf("--client-cert and --client-key are only valid for %s credentials", credentialTypeCert)
+	}
+
+	return nil
+}
+
+// getCredential returns the credential with the given name.
+func getCredential(credentialName string) (Credential, bool) {
+	for _, c := range Config.Credentials {
+		if c.Name == credentialName {
+			return c, true
+		}
+	}
+	return Credential{}, false
+}
+
+// getCredentialSource returns where the secret for a credential comes from.
+func getCredentialSource(credential Credential) string {
+	if credential.Type == credentialTypeCert {
+		return credentialNone
+	}
+	if credential.Command != "" {
+		return credentialSourceCmd
+	}
+	return credentialSourceStore
+}
+
+// readCredentialSecret reads a password or token from stdin or the terminal.
+func readCredentialSecret(cmd *cobra.Command, credType string) (string, error) {
+	var secret string
+
+	name := "password"
+	if credType == credentialTypeToken {
+		name = "token"
+	}
+
+	if readPassStdin {
+		scanner := bufio.NewScanner(os.Stdin)
+		if scanner.Scan() {
+			secret = scanner.Text()
+		}
+	} else {
+		cmd.Printf("Enter %s: ", name)
+		secretBytes, err := term.ReadPassword(int(os.Stdin.Fd()))
+		if err != nil {
+			return "", err
+		}
+		cmd.Println()
+		secret = string(secretBytes)
+	}
+
+	if secret == "" {
+		return "", fmt.Errorf("you must provide a %s", name)
+	}
+
+	return secret, nil
+}
+
+// getCredentialAuth returns the authentication details for a credential, retrieving the
+// secret from the credential store or external command.
+func getCredentialAuth(credentialName string) (*fetcher.Auth, error) {
+	credential, found := getCredential(credentialName)
+	if !found {
+		return nil, fmt.Errorf("a credential with the name %s does not exist", credentialName)
+	}
+
+	if credential.Type == credentialTypeCert {
+		certificate, err := tls.LoadX509KeyPair(credential.ClientCert, credential.ClientKey)
+		if err != nil {
+			return nil, utils.GetError("unable to load client certificate and key for credential "+credentialName, err)
+		}
+		return &fetcher.Auth{Certificates: []tls.Certificate{certificate}}, nil
+	}
+
+	secret, err := getCredentialSecret(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	if credential.Type == credentialTypeToken {
+		return &fetcher.Auth{Token: secret}, nil
+	}
+
+	return &fetcher.Auth{Username: credential.Username, Password: secret}, nil
+}
+
+// getCredentialSecret returns the secret for a credential from the external command or the store.
+func getCredentialSecret(credential Credential) (string, error) {
+	if credential.Command != "" {
+		return runCredentialCommand(credential.Command)
+	}
+
+	secrets, err := loadCredentialSecrets()
+	if err != nil {
+		return "", err
+	}
+
+	secret, ok := secrets[credential.Name]
+	if !ok {
+		return "", fmt.Errorf("no secret is stored for credential %s", credential.Name)
+	}
+
+	return secret, nil
+}
+
+// runCredentialCommand runs an external command and returns the first line of the output as the secret.
+func runCredentialCommand(command string) (string, error) {
+	var process *exec.Cmd
+	if isWindows() {
+		process = exec.Command("cmd", "/C", command) // #nosec G204
+	} else {
+		process = exec.Command("sh", "-c", command) // #nosec G204
+	}
+	process.Stdin = os.Stdin
+	process.Stderr = os.Stderr
+
+	output, err := process.Output()
+	if err != nil {
+		return "", utils.GetError("unable to run credential command", err)
+	}
+
+	secret := strings.TrimSpace(strings.SplitN(string(output), "\n", 2)[0])
+	if secret == "" {
+		return "", errors.New("the credential command did not return a value")
+	}
+
+	return secret, nil
+}
+
+// setCredentialSecret stores the secret for a credential, removing it if the secret is empty.
+func setCredentialSecret(credentialName, secret string) error {
+	secrets, err := loadCredentialSecrets()
+	if err != nil {
+		return err
+	}
+
+	if secret == "" {
+		if _, ok := secrets[credentialName]; !ok {
+			return nil
+		}
+		delete(secrets, credentialName)
+	} else {
+		secrets[credentialName] = secret
+	}
+
+	return saveCredentialSecrets(secrets)
+}
+
+// loadCredentialSecrets loads and decrypts the secrets from the credential store.
+func loadCredentialSecrets() (map[string]string, error) {
+	var secrets = make(map[string]string)
+
+	data, err := os.ReadFile(filepath.Join(cfgDirectory, credentialsStoreFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return secrets, nil
+		}
+		return nil, err
+	}
+
+	key, data, err := getCredentialKeyForStore(data)
+	if err != nil {
+		return nil, err
+	}
+
+	plainText, err := decryptCredentials(key, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(plainText, &secrets); err != nil {
+		return nil, utils.GetError("unable to decode credential store", err)
+	}
+
+	return secrets, nil
+}
+
+// saveCredentialSecrets encrypts and saves the secrets to the credential store. If the key is
+// derived from a passphrase, the store is prefixed with a new random salt.
+func saveCredentialSecrets(secrets map[string]string) error {
+	var (
+		key    []byte
+		prefix []byte
+		err    error
+	)
+
+	if passphrase := os.Getenv(credentialsKeyEnv); passphrase != "" {
+		prefix = make([]byte, credentialSaltLength)
+		if _, err = io.ReadFull(rand.Reader, prefix); err != nil {
+			return err
+		}
+		if key, err = deriveCredentialKey(passphrase, prefix); err != nil {
+			return err
+		}
+	} else if key, err = getCredentialKeyFile(true); err != nil {
+		return err
+	}
+
+	plainText, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	data, err := encryptCredentials(key, plainText)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(cfgDirectory, credentialsStoreFile), append(prefix, data...), credentialFilePerms)
+}
+
+// getCredentialKeyForStore returns the key to decrypt the credential store and the encrypted data.
+// If the COHCTL_CREDENTIALS_KEY environment variable is set then the key is derived from it using
+// the salt at the start of the store, otherwise the key is read from the config directory.
+func getCredentialKeyForStore(data []byte) ([]byte, []byte, error) {
+	if passphrase := os.Getenv(credentialsKeyEnv); passphrase != "" {
+		if len(data) < credentialSaltLength {
+			return nil, nil, errors.New("invalid credential store")
+		}
+		key, err := deriveCredentialKey(passphrase, data[:credentialSaltLength])
+		return key, data[credentialSaltLength:], err
+	}
+
+	key, err := getCredentialKeyFile(false)
+	return key, data, err
+}
+
+// deriveCredentialKey derives the key used to encrypt the credential store from a passphrase using scrypt.
+func deriveCredentialKey(passphrase string, salt []byte) ([]byte, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, credentialScryptN, credentialScryptR, credentialScryptP, credentialKeyLength)
+	if err != nil {
+		return nil, utils.GetError("unable to derive credential key", err)
+	}
+	return key, nil
+}
+
+// getCredentialKeyFile returns the key saved in the config directory, generating and saving
+// a random key if create is true and the key does not exist.
+func getCredentialKeyFile(create bool) ([]byte, error) {
+	keyFile := filepath.Join(cfgDirectory, credentialsKeyFile)
+	key, err := os.ReadFile(keyFile)
+	if err == nil {
+		if len(key) != credentialKeyLength {
+			return nil, fmt.Errorf("invalid credential key in %s", keyFile)
+		}
+		return key, nil
+	}
+
+	if !os.IsNotExist(err) || !create {
+		return nil, utils.GetError("unable to read credential key "+keyFile, err)
+	}
+
+	key = make([]byte, credentialKeyLength)
+	if _, err = io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+
+	if err = os.WriteFile(keyFile, key, credentialFilePerms); err != nil {
+		return nil, utils.GetError("unable to write credential key "+keyFile, err)
+	}
+
+	return key, nil
+}
+
+// encryptCredentials encrypts the data using AES-GCM, prefixing the result with the nonce.
+func encryptCredentials(key, plainText []byte) ([]byte, error) {
+	gcm, err := newCredentialsCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plainText, nil), nil
+}
+
+// decryptCredentials decrypts data encrypted using encryptCredentials.
+func decryptCredentials(key, data []byte) ([]byte, error) {
+	gcm, err := newCredentialsCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("invalid credential store")
+	}
+
+	plainText, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.New("unable to decrypt credential store, check the credential key")
+	}
+
+	return plainText, nil
+}
+
+// newCredentialsCipher returns the AES-GCM cipher for the key.
+func newCredentialsCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
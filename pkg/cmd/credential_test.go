@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCredentialStore(t *testing.T) {
+	var (
+		g           = gomega.NewGomegaWithT(t)
+		originalDir = cfgDirectory
+	)
+
+	cfgDirectory = t.TempDir()
+	defer func() { cfgDirectory = originalDir }()
+
+	g.Expect(setCredentialSecret("prod", "secret-value")).To(gomega.Succeed())
+
+	// the secret must not be stored in plain text
+	data, err := os.ReadFile(filepath.Join(cfgDirectory, credentialsStoreFile))
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.Not(gomega.ContainSubstring("secret-value")))
+
+	Config.Credentials = []Credential{{Name: "prod", Type: credentialTypeBasic, Username: "admin"}}
+	defer func() { Config.Credentials = nil }()
+
+	auth, err := getCredentialAuth("prod")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(auth.Username).To(gomega.Equal("admin"))
+	g.Expect(auth.Password).To(gomega.Equal("secret-value"))
+
+	g.Expect(setCredentialSecret("prod", "")).To(gomega.Succeed())
+	_, err = getCredentialAuth("prod")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	// a different key must not decrypt the store
+	g.Expect(setCredentialSecret("prod", "secret-value")).To(gomega.Succeed())
+	t.Setenv(credentialsKeyEnv, "another-key")
+	_, err = loadCredentialSecrets()
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestCredentialStoreWithPassphrase(t *testing.T) {
+	var (
+		g           = gomega.NewGomegaWithT(t)
+		originalDir = cfgDirectory
+	)
+
+	cfgDirectory = t.TempDir()
+	defer func() { cfgDirectory = originalDir }()
+
+	t.Setenv(credentialsKeyEnv, "my-passphrase")
+	g.Expect(setCredentialSecret("prod", "secret-value")).To(gomega.Succeed())
+
+	// the key is derived from the passphrase so no key file is written
+	_, err := os.Stat(filepath.Join(cfgDirectory, credentialsKeyFile))
+	g.Expect(os.IsNotExist(err)).To(gomega.BeTrue())
+
+	data1, err := os.ReadFile(filepath.Join(cfgDirectory, credentialsStoreFile))
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+	// a new salt is used each time the store is saved
+	g.Expect(setCredentialSecret("prod", "secret-value")).To(gomega.Succeed())
+	data2, err := os.ReadFile(filepath.Join(cfgDirectory, credentialsStoreFile))
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(data2[:credentialSaltLength]).To(gomega.Not(gomega.Equal(data1[:credentialSaltLength])))
+
+	secrets, err := loadCredentialSecrets()
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(secrets["prod"]).To(gomega.Equal("secret-value"))
+
+	t.Setenv(credentialsKeyEnv, "wrong-passphrase")
+	_, err = loadCredentialSecrets()
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestValidateCredential(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	g.Expect(validateCredential(Credential{Type: credentialTypeBasic, Username: "admin"})).To(gomega.Succeed())
+	g.Expect(validateCredential(Credential{Type: credentialTypeToken, Command: "pass show token"})).To(gomega.Succeed())
+	g.Expect(validateCredential(Credential{Type: credentialTypeBasic})).To(gomega.HaveOccurred())
+	g.Expect(validateCredential(Credential{Type: "kerberos"})).To(gomega.HaveOccurred())
+	g.Expect(validateCredential(Credential{Type: credentialTypeCert})).To(gomega.HaveOccurred())
+	g.Expect(validateCredential(Credential{Type: credentialTypeToken, ClientCert: "cert.pem"})).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,476 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	bundleOutputDir       string
+	bundleSkipThreadDumps bool
+)
+
+const (
+	bundleManifestFile    = "manifest.json"
+	bundleTimestampFormat = "20060102-150405"
+	bundleThreadDumpsDir  = "thread-dumps"
+
+	// names of the entries in a diagnostic bundle
+	bundleCluster             = "cluster"
+	bundleClusterDescription  = "cluster-description"
+	bundleMembers             = "members"
+	bundleServices            = "services"
+	bundleServiceDescriptions = "service-descriptions"
+	bundleCaches              = "caches"
+	bundleCacheConfigs        = "cache-configs"
+	bundleTopics              = "topics"
+	bundleFederationOutgoing  = "federation-outgoing"
+	bundleFederationIncoming  = "federation-incoming"
+	bundlePersistence         = "persistence"
+	bundleReporters           = "reporters"
+	bundleHealth              = "health"
+	bundleProxies             = "proxies"
+	bundleClusterConfig       = "cluster-config"
+	bundleThreadDumpEntryName = "thread-dump-node-"
+)
+
+// diagnosticBundleManifest describes the contents of a diagnostic bundle.
+type diagnosticBundleManifest struct {
+	Connection    string                  `json:"connection"`
+	ClusterName   string                  `json:"clusterName"`
+	URL           string                  `json:"url"`
+	Created       string                  `json:"created"`
+	CohctlVersion string                  `json:"cohctlVersion"`
+	Entries       []diagnosticBundleEntry `json:"entries"`
+}
+
+// diagnosticBundleEntry describes an individual entry in a diagnostic bundle.
+type diagnosticBundleEntry struct {
+	Name      string `json:"name"`
+	File      string `json:"file"`
+	Collected string `json:"collected"`
+	Size      int    `json:"size"`
+	Error     string `json:"error,omitempty"`
+}
+
+// diagnosticBundle contains the manifest and contents of a loaded diagnostic bundle.
+type diagnosticBundle struct {
+	manifest diagnosticBundleManifest
+	contents map[string][]byte
+}
+
+// bundleCollector retrieves the data for a bundle entry.
+type bundleCollector struct {
+	name      string
+	extension string
+	collect   func(dataFetcher fetcher.Fetcher) ([]byte, error)
+}
+
+// createDiagnosticBundleCmd represents the create diagnostic-bundle command.
+var createDiagnosticBundleCmd = &cobra.Command{
+	Use:   "diagnostic-bundle",
+	Short: "create a point-in-time diagnostic bundle for a cluster",
+	Long: `The 'create diagnostic-bundle' command gathers the cluster description, members, services,
+caches, topics, federation, persistence, reporters, thread dumps and the cluster operational
+config and saves them in a single .tar.gz file along with a manifest. The JSON files contain
+the same data structures as the equivalent commands with '-o json'.`,
+	Args: cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		var (
+			dataFetcher fetcher.Fetcher
+			connection  string
+			err         error
+		)
+
+		connection, dataFetcher, err = GetConnectionAndDataFetcher()
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(FormatCurrentCluster(connection))
+
+		if !utils.DirectoryExists(bundleOutputDir) {
+			return fmt.Errorf("the output directory '%s' does not exist or is not writable", bundleOutputDir)
+		}
+
+		bundleFile, manifest, err := createDiagnosticBundle(cmd, connection, dataFetcher)
+		if err != nil {
+			return err
+		}
+
+		errorCount := 0
+		for _, entry := range manifest.Entries {
+			if entry.Error != "" {
+				errorCount++
+			}
+		}
+
+		cmd.Printf("Diagnostic bundle with %d entries written to %s\n", len(manifest.Entries), bundleFile)
+		if errorCount > 0 {
+			cmd.Printf("%d entries could not be collected, see %s in the bundle for details\n", errorCount, bundleManifestFile)
+		}
+
+		return nil
+	},
+}
+
+// getBundleCollectors returns the collectors for each of the cluster-wide entries in a bundle.
+func getBundleCollectors() []bundleCollector {
+	return []bundleCollector{
+		{bundleCluster, "json", func(f fetcher.Fetcher) ([]byte, error) { return f.GetClusterDetailsJSON() }},
+		{bundleClusterDescription, "json", func(f fetcher.Fetcher) ([]byte, error) { return f.GetClusterDescriptionJSON() }},
+		{bundleMembers, "json", func(f fetcher.Fetcher) ([]byte, error) { return f.GetMemberDetailsJSON(true) }},
+		{bundleServices, "json", func(f fetcher.Fetcher) ([]byte, error) { return f.GetServiceDetailsJSON() }},
+		{bundleServiceDescriptions, "json", getBundleServiceDescriptions},
+		{bundleCaches, "json", func(f fetcher.Fetcher) ([]byte, error) { return f.GetCachesSummaryJSONAllServices() }},
+		{bundleCacheConfigs, "json", getBundleCacheConfigs},
+		{bundleTopics, "json", func(f fetcher.Fetcher) ([]byte, error) { return f.GetTopicsJSON() }},
+		{bundleFederationOutgoing, "json", func(f fetcher.Fetcher) ([]byte, error) { return getBundleFederation(f, outgoing) }},
+		{bundleFederationIncoming, "json", func(f fetcher.Fetcher) ([]byte, error) { return getBundleFederation(f, incoming) }},
+		{bundlePersistence, "json", getBundlePersistence},
+		{bundleReporters, "json", func(f fetcher.Fetcher) ([]byte, error) { return f.GetReportersJSON() }},
+		{bundleHealth, "json", func(f fetcher.Fetcher) ([]byte, error) { return f.GetMembersHealth() }},
+		{bundleProxies, "json", func(f fetcher.Fetcher) ([]byte, error) { return f.GetProxySummaryJSON() }},
+		{bundleClusterConfig, "xml", func(f fetcher.Fetcher) ([]byte, error) { return f.GetClusterConfig() }},
+	}
+}
+
+// createDiagnosticBundle collects all the data for a cluster and writes the bundle. Failure to
+// collect an individual entry is recorded in the manifest rather than failing the whole bundle.
+func createDiagnosticBundle(cmd *cobra.Command, connection string, dataFetcher fetcher.Fetcher) (string, diagnosticBundleManifest, error) {
+	var (
+		now        = time.Now()
+		bundleName = fmt.Sprintf("diagnostic-bundle-%s-%s", connection, now.Format(bundleTimestampFormat))
+		bundleFile = filepath.Join(bundleOutputDir, bundleName+".tar.gz")
+		contents   = make(map[string][]byte)
+		cluster    = config.Cluster{}
+		manifest   = diagnosticBundleManifest{Connection: connection, URL: dataFetcher.GetURL(),
+			Created: now.Format(time.RFC3339), CohctlVersion: Version}
+	)
+
+	addEntry := func(name, file string, data []byte, err error) {
+		entry := diagnosticBundleEntry{Name: name, File: file, Collected: time.Now().Format(time.RFC3339), Size: len(data)}
+		if err != nil {
+			entry.Error = err.Error()
+		} else {
+			contents[file] = data
+		}
+		manifest.Entries = append(manifest.Entries, entry)
+	}
+
+	for _, collector := range getBundleCollectors() {
+		cmd.Printf("Collecting %s\n", collector.name)
+		data, err := collector.collect(dataFetcher)
+		addEntry(collector.name, collector.name+"."+collector.extension, data, err)
+
+		if collector.name == bundleCluster && err == nil {
+			if err = json.Unmarshal(data, &cluster); err == nil {
+				manifest.ClusterName = cluster.ClusterName
+			}
+		}
+	}
+
+	if !bundleSkipThreadDumps {
+		nodeIDs, err := GetClusterNodeIDs(dataFetcher)
+		if err != nil {
+			addEntry(bundleThreadDumpsDir, bundleThreadDumpsDir, nil, err)
+		}
+		for _, nodeID := range nodeIDs {
+			cmd.Printf("Collecting thread dump for node %s\n", nodeID)
+			var threadDump string
+			data, err := dataFetcher.GetThreadDump(nodeID)
+			if err == nil {
+				threadDump, err = UnmarshalThreadDump(data)
+			}
+			addEntry(bundleThreadDumpEntryName+nodeID, path.Join(bundleThreadDumpsDir, GetFileName(nodeID, 1)), []byte(threadDump), err)
+		}
+	}
+
+	if err := writeDiagnosticBundle(bundleFile, bundleName, manifest, contents); err != nil {
+		return "", manifest, err
+	}
+
+	return bundleFile, manifest, nil
+}
+
+// getBundleFederation returns the federation summaries for all federated services for the target.
+func getBundleFederation(dataFetcher fetcher.Fetcher, target string) ([]byte, error) {
+	federatedServices, err := GetFederatedServices(dataFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := getFederationSummaries(federatedServices, target, dataFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(config.FederationSummaries{Services: summaries})
+}
+
+// getBundleServiceDescriptions returns the descriptions for all distributed services as a
+// JSON object keyed by service name.
+func getBundleServiceDescriptions(dataFetcher fetcher.Fetcher) ([]byte, error) {
+	var descriptions = make(map[string]string)
+
+	services, err := GetDistributedServices(dataFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range services {
+		var description = config.Description{}
+
+		data, err := dataFetcher.GetServiceDescriptionJSON(service)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) > 0 {
+			if err = json.Unmarshal(data, &description); err != nil {
+				return nil, utils.GetError("unable to unmarshall service description", err)
+			}
+		}
+		descriptions[service] = description.Description
+	}
+
+	return json.Marshal(descriptions)
+}
+
+// getBundleCacheConfigs returns the configuration attributes for each cache keyed by service/cache.
+// The attributes are taken from the first back tier member, or the first member if there is no back tier.
+func getBundleCacheConfigs(dataFetcher fetcher.Fetcher) ([]byte, error) {
+	var (
+		caches  = config.CacheSummaries{}
+		configs = make(map[string]map[string]string)
+	)
+
+	data, err := dataFetcher.GetCachesSummaryJSONAllServices()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &caches); err != nil {
+			return nil, utils.GetError("unable to unmarshall caches summary", err)
+		}
+	}
+
+	for _, cache := range caches.Caches {
+		var members = struct {
+			Items []map[string]interface{} `json:"items"`
+		}{}
+
+		data, err = dataFetcher.GetCacheMembers(cache.ServiceName, cache.CacheName)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == 0 {
+			continue
+		}
+		if err = json.Unmarshal(data, &members); err != nil {
+			return nil, utils.GetError("unable to unmarshall cache members", err)
+		}
+		if len(members.Items) == 0 {
+			continue
+		}
+
+		member := members.Items[0]
+		for _, item := range members.Items {
+			if item["tier"] == "back" {
+				member = item
+				break
+			}
+		}
+
+		attributes := make(map[string]string)
+		for _, attribute := range cacheConfigAttributes {
+			if value, ok := member[attribute]; ok {
+				attributes[attribute] = formatCacheConfigValue(value)
+			}
+		}
+		configs[cache.ServiceName+"/"+cache.CacheName] = attributes
+	}
+
+	return json.Marshal(configs)
+}
+
+// formatCacheConfigValue formats a cache attribute value returned from the management API.
+func formatCacheConfigValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// getBundlePersistence returns the persistence details for all services.
+func getBundlePersistence(dataFetcher fetcher.Fetcher) ([]byte, error) {
+	var servicesSummary = config.ServicesSummaries{}
+
+	servicesResult, err := dataFetcher.GetServiceDetailsJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(servicesResult, &servicesSummary); err != nil {
+		return nil, utils.GetError("unable to unmarshall service result", err)
+	}
+
+	deDuplicatedServices := DeduplicatePersistenceServices(servicesSummary)
+
+	if err = processPersistenceServices(deDuplicatedServices, dataFetcher); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(config.ServicesSummaries{Services: deDuplicatedServices})
+}
+
+// writeDiagnosticBundle writes the manifest and contents to a .tar.gz file with all entries under bundleName.
+func writeDiagnosticBundle(bundleFile, bundleName string, manifest diagnosticBundleManifest, contents map[string][]byte) error {
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return utils.GetError("unable to marshal manifest", err)
+	}
+
+	file, err := os.Create(filepath.Clean(bundleFile))
+	if err != nil {
+		return utils.GetError("unable to create bundle "+bundleFile, err)
+	}
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+
+	if err = writeTarEntry(tw, path.Join(bundleName, bundleManifestFile), manifestData, time.Now()); err != nil {
+		return err
+	}
+
+	for _, entry := range manifest.Entries {
+		if data, ok := contents[entry.File]; ok {
+			collected, _ := time.Parse(time.RFC3339, entry.Collected)
+			if err = writeTarEntry(tw, path.Join(bundleName, entry.File), data, collected); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// writeTarEntry writes a single file to a tar archive.
+func writeTarEntry(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	header := &tar.Header{Name: name, Mode: 0600, Size: int64(len(data)), ModTime: modTime, Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(header); err != nil {
+		return utils.GetError("unable to write header for "+name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return utils.GetError("unable to write "+name, err)
+	}
+	return nil
+}
+
+// loadDiagnosticBundle loads a diagnostic bundle created by the create diagnostic-bundle command.
+func loadDiagnosticBundle(bundleFile string) (diagnosticBundle, error) {
+	var (
+		bundle   = diagnosticBundle{contents: make(map[string][]byte)}
+		all      = make(map[string][]byte)
+		baseDir  string
+		manifest []byte
+	)
+
+	file, err := os.Open(filepath.Clean(bundleFile))
+	if err != nil {
+		return bundle, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return bundle, utils.GetError("unable to read bundle "+bundleFile, err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return bundle, utils.GetError("unable to read bundle "+bundleFile, err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return bundle, err
+		}
+		name := path.Clean(header.Name)
+		all[name] = data
+		if path.Base(name) == bundleManifestFile && manifest == nil {
+			baseDir = path.Dir(name)
+			manifest = data
+		}
+	}
+
+	if manifest == nil {
+		return bundle, fmt.Errorf("bundle %s does not contain a %s", bundleFile, bundleManifestFile)
+	}
+
+	if err = json.Unmarshal(manifest, &bundle.manifest); err != nil {
+		return bundle, utils.GetError("unable to unmarshal bundle manifest", err)
+	}
+
+	for name, data := range all {
+		if baseDir == "." {
+			bundle.contents[name] = data
+		} else if relative, ok := strings.CutPrefix(name, baseDir+"/"); ok {
+			bundle.contents[relative] = data
+		}
+	}
+
+	return bundle, nil
+}
+
+// getEntry returns the contents of the named entry in a bundle, or an error if
+// the entry was not collected.
+func (b diagnosticBundle) getEntry(name string) ([]byte, error) {
+	for _, entry := range b.manifest.Entries {
+		if entry.Name == name {
+			if entry.Error != "" {
+				return nil, fmt.Errorf("entry %s was not collected: %s", name, entry.Error)
+			}
+			return b.contents[entry.File], nil
+		}
+	}
+	return nil, fmt.Errorf("entry %s not found in bundle", name)
+}
+
+func init() {
+	createDiagnosticBundleCmd.Flags().StringVarP(&bundleOutputDir, "output-dir", "O", ".", "existing local directory to write the bundle to")
+	createDiagnosticBundleCmd.Flags().BoolVarP(&bundleSkipThreadDumps, "skip-thread-dumps", "", false, "do not include thread dumps for each member")
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/fatih/color"
 	"github.com/mattn/go-runewidth"
 	"github.com/oracle/coherence-cli/pkg/config"
 	"github.com/oracle/coherence-cli/pkg/constants"
@@ -19,6 +20,7 @@ import (
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -29,6 +31,7 @@ const (
 	R                     = "R"
 	L                     = "L"
 	NodeIDColumn          = "NODE ID"
+	clusterColumn         = "CLUSTER"
 	SubscriberIDColumn    = "SUBSCRIBER ID"
 	ServiceColumn         = "SERVICE"
 	CacheColumn           = "CACHE"
@@ -111,6 +114,118 @@ func FormatCluster(cluster config.Cluster) string {
 	return sb.String()
 }
 
+// FormatCurrentClusters will display a message indicating the cluster connections being used.
+func FormatCurrentClusters(connections []string) string {
+	return fmt.Sprintf("Using cluster connections %s\n", strings.Join(connections, ", "))
+}
+
+// FormatMultiClusterMembers returns the members for multiple clusters in column formatted output.
+func FormatMultiClusterMembers(results []multiClusterResult[clusterMembers]) string {
+	var formattingFunction = getFormattingFunction()
+
+	table := newFormattedTable().WithHeader(clusterColumn, NodeIDColumn, AddressColumn, PortColumn, ProcessColumn,
+		MemberColumn, RoleColumn, "STORAGE", MaxHeapColumn, UsedHeapColumn, AvailHeapColumn).
+		WithAlignment(L, R, L, R, R, L, L, L, R, R, R).WithSortingColumn(clusterColumn)
+
+	for _, result := range results {
+		for _, value := range result.Items.Members {
+			nodeID, _ := strconv.Atoi(value.NodeID)
+			table.AddRow(result.Cluster, formatSmallInteger(int32(nodeID)), value.UnicastAddress,
+				formatPort(value.UnicastPort), value.ProcessName, value.MemberName, value.RoleName,
+				fmt.Sprintf("%v", utils.IsStorageEnabled(nodeID, result.Items.storageMap)),
+				formattingFunction(int64(value.MemoryMaxMB)*MB),
+				formattingFunction(int64(value.MemoryMaxMB-value.MemoryAvailableMB)*MB),
+				formattingFunction(int64(value.MemoryAvailableMB)*MB))
+		}
+	}
+
+	return table.String()
+}
+
+// FormatMultiClusterServices returns the services for multiple clusters in column formatted output.
+func FormatMultiClusterServices(results []multiClusterResult[[]config.ServiceSummary]) string {
+	table := newFormattedTable().WithHeader(clusterColumn, ServiceNameColumn, "TYPE", MembersColumn, "STATUS HA",
+		"STORAGE", partitions).WithAlignment(L, L, L, R, L, R, R).WithSortingColumn(clusterColumn)
+	table.AddFormattingFunction(4, statusHAFormatter)
+
+	for _, result := range results {
+		for _, value := range result.Items {
+			storageCount := value.StorageEnabledCount
+			if storageCount == -1 {
+				storageCount = 0
+			}
+			table.AddRow(result.Cluster, value.ServiceName, value.ServiceType, formatSmallInteger(value.MemberCount),
+				value.StatusHA, formatSmallInteger(storageCount), formatSmallIntegerOrDash(value.PartitionsAll))
+		}
+	}
+
+	return table.String()
+}
+
+// FormatMultiClusterCaches returns the caches for multiple clusters in column formatted output.
+func FormatMultiClusterCaches(results []multiClusterResult[[]config.CacheSummaryDetail]) string {
+	var formattingFunction = getFormattingFunction()
+
+	table := newFormattedTable().WithHeader(clusterColumn, ServiceColumn, CacheColumn, CountColumn, "SIZE").
+		WithAlignment(L, L, L, R, R).WithSortingColumn(clusterColumn)
+
+	for _, result := range results {
+		for _, value := range result.Items {
+			table.AddRow(result.Cluster, value.ServiceName, value.CacheName, formatLargeInteger(int64(value.CacheSize)),
+				formattingFunction(value.UnitsBytes))
+		}
+	}
+
+	return table.String()
+}
+
+// FormatMultiClusterHealth returns the health summary for multiple clusters in column formatted output.
+func FormatMultiClusterHealth(results []multiClusterResult[[]config.HealthSummaryShort]) string {
+	table := newFormattedTable().WithHeader(clusterColumn, NameColumn, "SUB TYPE", MembersColumn, "STARTED", "LIVE",
+		"READY", "SAFE").WithAlignment(L, L, L, R, R, R, R, R).WithSortingColumn(clusterColumn)
+	for i := 4; i <= 7; i++ {
+		table.AddFormattingFunction(i, healthSummaryFormatter)
+	}
+
+	for _, result := range results {
+		for _, value := range result.Items {
+			table.AddRow(result.Cluster, value.Name, value.SubType, formatSmallInteger(value.TotalCount),
+				getCountString(value.TotalCount, value.StartedCount),
+				getCountString(value.TotalCount, value.LiveCount),
+				getCountString(value.TotalCount, value.ReadyCount),
+				getCountString(value.TotalCount, value.SafeCount))
+		}
+	}
+
+	return table.String()
+}
+
+// FormatClustersOverview returns the member count, StatusHA and health for multiple clusters.
+func FormatClustersOverview(results []multiClusterResult[clusterOverview]) string {
+	table := newFormattedTable().WithHeader(clusterColumn, "CLUSTER NAME", "VERSION", MembersColumn, "STORAGE",
+		"STATUS HA", "HEALTHY", "STATUS").WithAlignment(L, L, L, R, R, L, R, L).WithSortingColumn(clusterColumn)
+	table.AddFormattingFunction(5, statusHAFormatter)
+	table.AddFormattingFunction(6, healthSummaryFormatter)
+
+	for _, result := range results {
+		if result.Error != "" {
+			table.AddRow(result.Cluster, na, na, "0", "0", na, na, "ERROR: "+result.Error)
+			continue
+		}
+		var (
+			value   = result.Items
+			healthy = na
+		)
+		if value.HealthTotal > 0 {
+			healthy = getCountString(value.HealthTotal, value.HealthSafe)
+		}
+		table.AddRow(result.Cluster, value.ClusterName, value.Version, formatSmallInteger(value.MemberCount),
+			formatSmallInteger(value.StorageCount), value.StatusHA, healthy, "OK")
+	}
+
+	return table.String()
+}
+
 // FormatJSONForDescribe formats a two column display for a describe command
 // showAllColumns indicates if all the columns including ordered are shown
 // orderedColumns are the column names, expanded, that should be displayed first for context.
@@ -1203,6 +1318,588 @@ func FormatPanels(panels []Panel) string {
 	return table.String()
 }
 
+// FormatRules returns the rules in a column formatted output.
+func FormatRules(rules []Rule) string {
+	if len(rules) == 0 {
+		return ""
+	}
+
+	table := newFormattedTable().WithHeader("RULE", "EXPRESSION").WithSortingColumn("RULE")
+
+	for _, value := range rules {
+		table.AddRow(value.Name, value.Expression)
+	}
+
+	return table.String()
+}
+
+// FormatCredentials returns the credentials and the connections using them in a column formatted output.
+func FormatCredentials(credentials []Credential) string {
+	if len(credentials) == 0 {
+		return ""
+	}
+
+	table := newFormattedTable().WithHeader("CREDENTIAL", "TYPE", "USERNAME", "SOURCE", "CONNECTIONS").
+		WithSortingColumn("CREDENTIAL")
+
+	for _, value := range credentials {
+		var (
+			source      = getCredentialSource(value)
+			connections = make([]string, 0)
+		)
+		if source == credentialSourceCmd {
+			source = "command: " + value.Command
+		} else if value.Type == credentialTypeCert {
+			source = value.ClientCert
+		}
+		for _, c := range Config.Clusters {
+			if c.Credential == value.Name {
+				connections = append(connections, c.Name)
+			}
+		}
+		table.AddRow(value.Name, value.Type, value.Username, source, strings.Join(connections, ","))
+	}
+
+	return table.String()
+}
+
+// FormatMemberProcesses returns the member processes in a column formatted output.
+func FormatMemberProcesses(processes []memberProcessDetails) string {
+	if len(processes) == 0 {
+		return ""
+	}
+
+	var formattingFunction = getFormattingFunction()
+
+	table := newFormattedTable().WithHeader(clusterColumn, "MEMBER", "PID", "STATUS", "UPTIME", "RSS", "RESTARTS", "LOG FILE").
+		WithAlignment(L, L, R, L, R, R, R, L).WithSortingColumn(clusterColumn)
+
+	for _, value := range processes {
+		var (
+			status = "EXITED"
+			uptime = "-"
+			rss    = "-"
+		)
+		if value.Running {
+			status = "RUNNING"
+			uptime = formatConnectionMillis(value.UptimeMillis)
+			if value.RSS >= 0 {
+				rss = formattingFunction(value.RSS)
+			}
+		}
+		table.AddRow(value.Cluster, value.Member, fmt.Sprintf("%d", value.PID), status, uptime, rss,
+			formatSmallInteger(value.Restarts), value.LogFile)
+	}
+
+	return table.String()
+}
+
+// FormatLogEvents returns the log events in a column formatted output.
+func FormatLogEvents(events []logEvent) string {
+	if len(events) == 0 {
+		return ""
+	}
+
+	table := newFormattedTable().WithHeader("TIME", "MEMBER", "EVENT", "DURATION", "MESSAGE").
+		WithAlignment(L, L, L, R, L).WithSortingColumn("TIME")
+
+	for _, value := range events {
+		duration := "-"
+		if value.DurationMillis > 0 {
+			duration = formatConnectionMillis(value.DurationMillis)
+		}
+		table.AddRow(value.Time.Format(logTimestampFormat), value.Member, value.Type, duration, value.Message)
+	}
+
+	return table.String()
+}
+
+// FormatLogEventSummary returns the summary of log events in a column formatted output.
+func FormatLogEventSummary(summaries []logEventSummary) string {
+	if len(summaries) == 0 {
+		return ""
+	}
+
+	table := newFormattedTable().WithHeader("EVENT", "COUNT", "MEMBERS", "FIRST", "LAST", "MAX DURATION").
+		WithAlignment(L, R, R, L, L, R)
+
+	for _, value := range summaries {
+		maxDuration := "-"
+		if value.MaxDurationMillis > 0 {
+			maxDuration = formatConnectionMillis(value.MaxDurationMillis)
+		}
+		table.AddRow(value.Type, formatSmallInteger(int32(value.Count)), formatSmallInteger(int32(len(value.Members))),
+			value.First.Format(logTimestampFormat), value.Last.Format(logTimestampFormat), maxDuration)
+	}
+
+	return table.String()
+}
+
+// FormatThreadGroups returns the largest thread groups in a column formatted output.
+func FormatThreadGroups(groups []threadGroup) string {
+	if len(groups) == 0 {
+		return ""
+	}
+
+	table := newFormattedTable().WithHeader("STATE", "THREADS", "SAMPLES", "TOP FRAME").
+		WithAlignment(L, R, R, L)
+
+	for i, value := range groups {
+		if i == maxDisplayedGroups {
+			break
+		}
+		topFrame := "-"
+		if len(value.Signature) > 0 {
+			topFrame = value.Signature[0]
+		}
+		table.AddRow(value.State, formatSmallInteger(int32(len(value.Threads))), formatSmallInteger(int32(value.Samples)), topFrame)
+	}
+
+	return table.String()
+}
+
+// FormatThreadDumpAnalysis returns the thread dump analysis for members in a column formatted output.
+func FormatThreadDumpAnalysis(members []memberThreadAnalysis) string {
+	if len(members) == 0 {
+		return ""
+	}
+
+	table := newFormattedTable().WithHeader(NodeIDColumn, "DUMPS", "THREADS", "RUNNABLE", "BLOCKED", "WAITING",
+		"TIMED WAITING", "BLOCKED ALL", "BUSY SERVICE", "DEADLOCKS").
+		WithAlignment(R, R, R, R, R, R, R, R, R, R)
+
+	for _, value := range members {
+		table.AddRow(value.NodeID, formatSmallInteger(int32(value.Dumps)), formatSmallInteger(int32(value.Threads)),
+			formatSmallInteger(int32(value.States[threadStateRunnable])), formatSmallInteger(int32(value.States[threadStateBlocked])),
+			formatSmallInteger(int32(value.States[threadStateWaiting])), formatSmallInteger(int32(value.States[threadStateTimedWaiting])),
+			formatSmallInteger(int32(len(value.BlockedThreads))), formatSmallInteger(int32(len(value.BusyServiceThreads))),
+			formatSmallInteger(int32(len(value.Deadlocks))))
+	}
+
+	return table.String()
+}
+
+// FormatPartitionSkew returns the partition skew for members, machines, racks and sites in a column formatted output.
+func FormatPartitionSkew(skews []partitionSkew) string {
+	if len(skews) == 0 {
+		return ""
+	}
+
+	table := newFormattedTable().WithHeader("LEVEL", "NAME", "MEMBERS", "PRIMARIES", "PRIMARY SKEW", "BACKUPS", "BACKUP SKEW").
+		WithAlignment(L, L, R, R, R, R, R)
+	table.AddFormattingFunction(4, skewFormatter)
+	table.AddFormattingFunction(6, skewFormatter)
+
+	for _, value := range skews {
+		table.AddRow(value.Level, value.Name, formatSmallInteger(int32(value.Members)),
+			formatSmallInteger(int32(value.Primaries)), fmt.Sprintf("%+.1f%%", value.PrimarySkew),
+			formatSmallInteger(int32(value.Backups)), fmt.Sprintf("%+.1f%%", value.BackupSkew))
+	}
+
+	return table.String()
+}
+
+// FormatPartitionSafety returns the number of partitions for each status HA value in a column formatted output.
+func FormatPartitionSafety(safetyCounts map[string]int) string {
+	if len(safetyCounts) == 0 {
+		return ""
+	}
+
+	table := newFormattedTable().WithHeader("STATUS HA", "PARTITIONS").WithAlignment(L, R)
+	table.AddFormattingFunction(0, statusHAFormatter)
+
+	for _, statusHA := range allStatusHA {
+		if count, ok := safetyCounts[statusHA]; ok {
+			table.AddRow(statusHA, formatSmallInteger(int32(count)))
+		}
+	}
+
+	return table.String()
+}
+
+// FormatPartitionHeatmap returns a heatmap of primary partition ownership for each member, where each
+// cell represents one or more partitions and is shaded by the percentage of those partitions the member
+// owns. The last row highlights cells containing endangered (X) or machine unsafe (!) partitions.
+func FormatPartitionHeatmap(analysis partitionAnalysis, width int) string {
+	if analysis.PartitionCount == 0 {
+		return ""
+	}
+
+	var (
+		sb            strings.Builder
+		shades        = []rune{' ', '░', '▒', '▓', '█'}
+		maxPartition  = 0
+		memberIDs     = make([]int, 0, len(analysis.memberPartitionMap))
+		useColor      = Config.Color == on && !isWindows() && !color.NoColor
+		redFunction   = color.New(color.FgRed).SprintFunc()
+		yellowFunc    = color.New(color.FgHiYellow).SprintFunc()
+		labelWidth    = len("Orphaned")
+		partitionCell int
+		cells         int
+	)
+
+	for partition := range analysis.partitionOwners {
+		maxPartition = max(maxPartition, partition)
+	}
+
+	partitionCell = (maxPartition + width) / width
+	cells = (maxPartition + partitionCell) / partitionCell
+
+	for memberID := range analysis.memberPartitionMap {
+		memberIDs = append(memberIDs, memberID)
+	}
+	sort.Slice(memberIDs, func(p, q int) bool {
+		// orphaned partitions are displayed last
+		if memberIDs[p] == orphanedID || memberIDs[q] == orphanedID {
+			return memberIDs[q] == orphanedID && memberIDs[p] != orphanedID
+		}
+		return memberIDs[p] < memberIDs[q]
+	})
+
+	sb.WriteString(fmt.Sprintf("Primary partition heatmap, each cell is %d partition(s)\n\n", partitionCell))
+
+	for _, memberID := range memberIDs {
+		label := "Orphaned"
+		if memberID != orphanedID {
+			label = fmt.Sprintf("%d", memberID)
+		}
+		sb.WriteString(fmt.Sprintf("%-*s |", labelWidth, label))
+		for cell := 0; cell < cells; cell++ {
+			var owned, total int
+			for partition := cell * partitionCell; partition < (cell+1)*partitionCell && partition <= maxPartition; partition++ {
+				total++
+				if analysis.memberPartitionMap[memberID][partition] {
+					owned++
+				}
+			}
+			shade := 0
+			if owned > 0 {
+				shade = 1 + (owned*(len(shades)-2))/total
+			}
+			sb.WriteRune(shades[shade])
+		}
+		sb.WriteString("|\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("%-*s |", labelWidth, "Unsafe"))
+	for cell := 0; cell < cells; cell++ {
+		marker := " "
+		for partition := cell * partitionCell; partition < (cell+1)*partitionCell && partition <= maxPartition; partition++ {
+			if analysis.partitionSafety[partition] == allStatusHA[0] {
+				marker = "X"
+				break
+			}
+			if slices.Contains(analysis.MachineUnsafe, partition) {
+				marker = "!"
+			}
+		}
+		if useColor && marker == "X" {
+			marker = redFunction(marker)
+		} else if useColor && marker == "!" {
+			marker = yellowFunc(marker)
+		}
+		sb.WriteString(marker)
+	}
+	sb.WriteString("|\n")
+
+	return sb.String()
+}
+
+// FormatHotPartitions returns the hot partitions for a cache in a column formatted output.
+func FormatHotPartitions(partitions []hotPartition) string {
+	if len(partitions) == 0 {
+		return ""
+	}
+
+	var formattingFunction = getFormattingFunction()
+
+	table := newFormattedTable().WithHeader(PartitionColumn, "OWNING MEMBER", CountColumn, "SIZE", "SIZE RATIO", "COUNT RATIO").
+		WithAlignment(R, R, R, R, R, R)
+
+	for _, value := range partitions {
+		table.AddRow(formatSmallInteger(value.PartitionID), formatSmallInteger(value.MemberID), formatSmallInteger(value.Count),
+			formattingFunction(value.TotalSize), fmt.Sprintf("%.1fx", value.SizeRatio), fmt.Sprintf("%.1fx", value.CountRatio))
+	}
+
+	return table.String()
+}
+
+// FormatCacheMemberSkew returns the cache data skew for storage members in a column formatted output.
+func FormatCacheMemberSkew(members []cacheMemberSkew) string {
+	if len(members) == 0 {
+		return ""
+	}
+
+	var formattingFunction = getFormattingFunction()
+
+	table := newFormattedTable().WithHeader(NodeIDColumn, "PARTITIONS", CountColumn, "SIZE", "MEMORY", "SKEW", "SKEWED").
+		WithAlignment(R, R, R, R, R, R, L)
+	table.AddFormattingFunction(5, skewFormatter)
+
+	for _, value := range members {
+		skewed := "-"
+		if value.Skewed {
+			skewed = "yes"
+		}
+		table.AddRow(value.NodeID, formatSmallInteger(int32(value.Partitions)), formatLargeInteger(value.Count),
+			formattingFunction(value.TotalSize), formattingFunction(value.UnitsBytes), fmt.Sprintf("%+.1f%%", value.Skew), skewed)
+	}
+
+	return table.String()
+}
+
+// FormatCapacityReport returns the capacity report for a cluster.
+func FormatCapacityReport(report capacityReport) string {
+	var (
+		sb                 strings.Builder
+		formattingFunction = getFormattingFunction()
+		usedPercent        float64
+	)
+
+	if report.TotalHeapBytes > 0 {
+		usedPercent = float64(report.UsedHeapBytes) / float64(report.TotalHeapBytes) * 100
+	}
+
+	formatMembers := func(value int) string {
+		if value < 0 {
+			return "unable to determine, the member overhead exceeds the heap threshold"
+		}
+		return fmt.Sprintf("%d", value)
+	}
+
+	sb.WriteString(fmt.Sprintf("Storage Members:        %d\n", report.StorageMembers))
+	sb.WriteString(fmt.Sprintf("Total Heap:             %s\n", formattingFunction(report.TotalHeapBytes)))
+	sb.WriteString(fmt.Sprintf("Used Heap:              %s (%.1f%%)\n", formattingFunction(report.UsedHeapBytes), usedPercent))
+	sb.WriteString(fmt.Sprintf("Heap Threshold:         %d%% (%s)\n", report.HeapThreshold, formattingFunction(report.ThresholdHeapBytes)))
+	sb.WriteString(fmt.Sprintf("Headroom:               %s\n", formattingFunction(report.HeadroomBytes)))
+	sb.WriteString(fmt.Sprintf("Primary Data:           %s\n", formattingFunction(report.PrimaryBytes)))
+	sb.WriteString(fmt.Sprintf("Backup Data:            %s\n", formattingFunction(report.BackupBytes)))
+	sb.WriteString(fmt.Sprintf("Member Overhead:        %s\n", formattingFunction(report.MemberOverheadBytes)))
+	sb.WriteString(fmt.Sprintf("Elastic Data RAM:       %s\n", formattingFunction(report.ElasticDataRAMBytes)))
+	sb.WriteString(fmt.Sprintf("Elastic Data Flash:     %s\n", formattingFunction(report.ElasticDataFlashBytes)))
+	sb.WriteString(fmt.Sprintf("Members Required:       %s\n", formatMembers(report.MembersRequired)))
+	sb.WriteString(fmt.Sprintf("Tolerable Member Loss:  %s\n", formatMembers(report.TolerableMemberLoss)))
+
+	if report.TargetPrimaryBytes > 0 {
+		sb.WriteString(fmt.Sprintf("Target Primary Data:    %s\n", formattingFunction(report.TargetPrimaryBytes)))
+		sb.WriteString(fmt.Sprintf("Members for Target:     %s\n", formatMembers(report.MembersForTarget)))
+	}
+
+	if report.MinBackupCount >= 0 && report.TolerableMemberLoss > report.MinBackupCount {
+		sb.WriteString(fmt.Sprintf("\nNote: more than %d member(s) lost at the same time may result in data loss as the minimum backup count is %d\n",
+			report.MinBackupCount, report.MinBackupCount))
+	}
+
+	return sb.String()
+}
+
+// FormatServiceCapacity returns the data stored by each service in a column formatted output.
+func FormatServiceCapacity(services []serviceCapacity) string {
+	if len(services) == 0 {
+		return ""
+	}
+
+	var formattingFunction = getFormattingFunction()
+
+	table := newFormattedTable().WithHeader(ServiceColumn, "BACKUP COUNT", "CACHES", "PRIMARY DATA", "BACKUP DATA",
+		"PARTITIONS", "MAX PARTITION", "AVG STORAGE").WithAlignment(L, R, R, R, R, R, R, R)
+
+	for _, value := range services {
+		table.AddRow(value.ServiceName, formatSmallInteger(int32(value.BackupCount)), formatSmallInteger(int32(value.Caches)),
+			formattingFunction(value.PrimaryBytes), formattingFunction(value.BackupBytes), formatSmallInteger(value.PartitionCount),
+			formattingFunction(value.MaxPartitionSizeKB*KB), formattingFunction(value.AverageStorageSizeKB*KB))
+	}
+
+	return table.String()
+}
+
+// FormatResolvedCacheScheme returns the resolved scheme chain for a cache.
+func FormatResolvedCacheScheme(resolved resolvedCacheScheme) string {
+	var (
+		sb          strings.Builder
+		valueOrNone = func(value string) string {
+			if value == "" {
+				return "n/a"
+			}
+			return value
+		}
+	)
+
+	sb.WriteString(fmt.Sprintf("Cache Name:    %s\n", resolved.CacheName))
+	sb.WriteString(fmt.Sprintf("Cache Mapping: %s\n", resolved.Mapping))
+	sb.WriteString(fmt.Sprintf("Scheme:        %s (%s)\n", resolved.SchemeName, resolved.SchemeType))
+	sb.WriteString(fmt.Sprintf("Service Name:  %s\n", valueOrNone(resolved.ServiceName)))
+	sb.WriteString(fmt.Sprintf("Backing Map:   %s\n", valueOrNone(resolved.BackingMap)))
+	sb.WriteString(fmt.Sprintf("Expiry Delay:  %s\n", valueOrNone(resolved.ExpiryDelay)))
+	sb.WriteString(fmt.Sprintf("Cache Store:   %s\n\n", valueOrNone(resolved.CacheStore)))
+	sb.WriteString("Scheme Chain:\n")
+
+	for _, entry := range resolved.Chain {
+		indent := strings.Repeat("  ", entry.Depth+1)
+		sb.WriteString(indent + entry.Element)
+		if entry.SchemeName != "" {
+			sb.WriteString(fmt.Sprintf(" [%s]", entry.SchemeName))
+		}
+		if entry.SchemeRef != "" {
+			sb.WriteString(fmt.Sprintf(" -> %s", entry.SchemeRef))
+		}
+		sb.WriteString("\n")
+		for _, property := range entry.Properties {
+			sb.WriteString(fmt.Sprintf("%s  %s: %s\n", indent, property.Name, property.Value))
+		}
+	}
+
+	return sb.String()
+}
+
+// FormatCacheMappings returns the cache mappings from a cache configuration.
+func FormatCacheMappings(config cacheConfig) string {
+	if len(config.mappings) == 0 {
+		return "No cache mappings found"
+	}
+
+	table := newFormattedTable().WithHeader(CacheColumn, "SCHEME NAME", "SCHEME TYPE", ServiceColumn)
+
+	for _, mapping := range config.mappings {
+		serviceName := ""
+		if scheme, ok := config.getScheme(mapping.SchemeName); ok {
+			if resolved, err := config.resolveSchemeRef(scheme, make(map[string]bool)); err == nil {
+				serviceName = resolved.childValue(serviceNameElement)
+			}
+		}
+		table.AddRow(mapping.CacheName, mapping.SchemeName, mapping.SchemeType, serviceName)
+	}
+
+	return table.String()
+}
+
+// FormatCacheConfigIssues returns the issues found when validating a cache configuration.
+func FormatCacheConfigIssues(issues []cacheConfigIssue) string {
+	if len(issues) == 0 {
+		return "No issues found"
+	}
+
+	table := newFormattedTable().WithHeader("SEVERITY", "ELEMENT", "MESSAGE")
+	table.AddFormattingFunction(0, cacheConfigSeverityFormatter)
+
+	for _, issue := range issues {
+		table.AddRow(issue.Severity, issue.Element, issue.Message)
+	}
+
+	return table.String()
+}
+
+// FormatSnapshotCoverage returns the partitions covered by a snapshot.
+func FormatSnapshotCoverage(coverage snapshotCoverage) string {
+	var (
+		sb           strings.Builder
+		snapshotType = "local"
+	)
+
+	if coverage.Archived {
+		snapshotType = "archived"
+	}
+
+	sb.WriteString(fmt.Sprintf("Service:             %s\n", coverage.ServiceName))
+	sb.WriteString(fmt.Sprintf("Snapshot:            %s (%s)\n", coverage.SnapshotName, snapshotType))
+	sb.WriteString(fmt.Sprintf("Exists:              %v\n", coverage.Exists))
+	sb.WriteString(fmt.Sprintf("Persistence Idle:    %v (%s)\n", coverage.Idle, coverage.OperationStatus))
+	sb.WriteString(fmt.Sprintf("Partition Count:     %d\n", coverage.PartitionCount))
+
+	if !coverage.Exists {
+		return sb.String()
+	}
+
+	if !coverage.StoresFound {
+		sb.WriteString("Coverage:            unknown, the snapshot stores are not available\n")
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf("Stores:              %d\n", coverage.Stores))
+	sb.WriteString(fmt.Sprintf("Covered Partitions:  %d\n", coverage.CoveredPartitions))
+	if coverage.Complete {
+		sb.WriteString("Coverage:            complete\n")
+	} else {
+		sb.WriteString(fmt.Sprintf("Missing Partitions:  %s\n", utils.FormatPartitions(coverage.MissingPartitions)))
+		sb.WriteString("Coverage:            incomplete, recovering this snapshot will result in data loss\n")
+	}
+
+	if len(coverage.Members) > 0 {
+		table := newFormattedTable().WithHeader(NodeIDColumn, "PARTITIONS").WithAlignment(R, R).WithSortingColumn(NodeIDColumn)
+		for _, value := range coverage.Members {
+			table.AddRow(formatSmallInteger(int32(value.MemberID)), formatSmallInteger(int32(value.Partitions)))
+		}
+		sb.WriteString("\n" + table.String())
+	}
+
+	return sb.String()
+}
+
+// FormatJFRRecordings returns the JFR recordings copied at the end of a session in column formatted output.
+func FormatJFRRecordings(recordings []jfrRecording) string {
+	var (
+		formattingFunction = getFormattingFunction()
+		totalSize          int64
+	)
+
+	table := newFormattedTable().WithHeader(NodeIDColumn, "FILE NAME", "SIZE").WithAlignment(R, L, R).WithSortingColumn(NodeIDColumn)
+
+	for _, value := range recordings {
+		totalSize += value.Size
+		table.AddRow(value.MemberID, value.FileName, formattingFunction(value.Size))
+	}
+
+	return fmt.Sprintf("Recordings: %d, Total Size: %s\n\n", len(recordings), formattingFunction(totalSize)) + table.String()
+}
+
+// FormatClustersTLS returns the TLS configuration for cluster connections in a column formatted output.
+func FormatClustersTLS(clusters []ClusterConnection) string {
+	if len(clusters) == 0 {
+		return ""
+	}
+
+	table := newFormattedTable().WithHeader("CONNECTION", "CA CERT", "CLIENT CERT", "CLIENT KEY", "MIN VERSION", "SERVER NAME").
+		WithSortingColumn("CONNECTION")
+
+	orDash := func(value string) string {
+		if value == "" {
+			return "-"
+		}
+		return value
+	}
+
+	for _, value := range clusters {
+		table.AddRow(value.Name, orDash(value.TLSCACert), orDash(value.TLSClientCert), orDash(value.TLSClientKey),
+			orDash(value.TLSMinVersion), orDash(value.TLSServerName))
+	}
+
+	return table.String()
+}
+
+// FormatRuleViolations returns the rule violations in a column formatted output.
+func FormatRuleViolations(violations []RuleViolation) string {
+	if len(violations) == 0 {
+		return ""
+	}
+
+	var multiCluster = violations[0].Cluster != ""
+
+	table := newFormattedTable().WithHeader("RULE", "TARGET", "VALUE", "EXPRESSION").WithSortingColumn("RULE")
+	if multiCluster {
+		table = newFormattedTable().WithHeader("CLUSTER", "RULE", "TARGET", "VALUE", "EXPRESSION").WithSortingColumn("CLUSTER")
+	}
+
+	for _, value := range violations {
+		if multiCluster {
+			table.AddRow(value.Cluster, value.Rule, value.Target, value.Value, value.Expression)
+		} else {
+			table.AddRow(value.Rule, value.Target, value.Value, value.Expression)
+		}
+	}
+
+	return fmt.Sprintf("Total Rule Violations: %d\n\n", len(violations)) + table.String()
+}
+
 // FormatClusterConnections returns the cluster information in a column formatted output.
 func FormatClusterConnections(clusters []ClusterConnection) string {
 	var (
@@ -1237,6 +1934,34 @@ func FormatClusterConnections(clusters []ClusterConnection) string {
 	return table.String()
 }
 
+// FormatClusterDifferences returns the differences between two sources in a column formatted output.
+// If includeMatches is true then all compared attributes are included.
+func FormatClusterDifferences(differences ClusterDifferences, includeMatches bool) string {
+	table := newFormattedTable().WithHeader("CATEGORY", NameColumn, "ATTRIBUTE", differences.Source1, differences.Source2).
+		WithSortingColumn("CATEGORY")
+	if includeMatches {
+		table.AddHeaderColumns("MATCH")
+	}
+
+	count := 0
+	for _, value := range differences.Differences {
+		if value.Match && !includeMatches {
+			continue
+		}
+		count++
+		table.AddRow(value.Category, value.Name, value.Attribute, value.Source1, value.Source2)
+		if includeMatches {
+			table.AddColumnsToRow(fmt.Sprintf("%v", value.Match))
+		}
+	}
+
+	if count == 0 {
+		return "No differences found"
+	}
+
+	return table.String()
+}
+
 // FormatTracing returns the member's tracing details in a column formatted output.
 func FormatTracing(members []config.Member) string {
 	var memberCount = len(members)
@@ -291,6 +291,42 @@ var networkStatsFormatter = func(s string) string {
 	return red(s)
 }
 
+// skewFormatter formats a column value which represents a percentage skew from the average.
+var skewFormatter = func(s string) string {
+	if isWindows() {
+		return s
+	}
+	floatValue, err := strconv.ParseFloat(trimPercent(s), 32)
+	if err != nil {
+		return s
+	}
+	if floatValue < 0 {
+		floatValue = -floatValue
+	}
+	if floatValue <= 10 {
+		return s
+	}
+	if floatValue <= 20 {
+		return yellow(s)
+	}
+	return red(s)
+}
+
+// cacheConfigSeverityFormatter formats a column value which represents the severity of a cache config issue.
+var cacheConfigSeverityFormatter = func(s string) string {
+	if isWindows() {
+		return s
+	}
+	if s == severityError {
+		return red(s)
+	}
+	if s == severityWarning {
+		return yellow(s)
+	}
+
+	return s
+}
+
 func getInt64Value(s string) (int64, error) {
 	return strconv.ParseInt(strings.ReplaceAll(strings.TrimSpace(s), ",", ""), 10, 64)
 }
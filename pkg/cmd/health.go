@@ -35,8 +35,11 @@ var (
 var getHealthCmd = &cobra.Command{
 	Use:   "health",
 	Short: "display health information for a cluster",
-	Long:  `The 'get health' command displays the health for members of a cluster.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `The 'get health' command displays the health for members of a cluster.
+If any rules have been added using 'add rule', they are evaluated and any violations
+are displayed and cause the command to return a non-zero exit code. Specify a comma separated
+list of connections, or 'all', using '--clusters' to display a health summary for multiple clusters.`,
+	Args: cobra.ExactArgs(0),
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		var (
 			err         error
@@ -44,6 +47,10 @@ var getHealthCmd = &cobra.Command{
 			connection  string
 		)
 
+		if clusterConnections != "" {
+			return runMultiClusterCommand(cmd, retrieveClusterHealth, FormatMultiClusterHealth, true)
+		}
+
 		connection, dataFetcher, err = GetConnectionAndDataFetcher()
 		if err != nil {
 			return err
@@ -81,6 +88,10 @@ var getHealthCmd = &cobra.Command{
 					filtered = filterHealth(healthSummaries)
 				)
 
+				if err = recordWatchSample(filtered); err != nil {
+					return err
+				}
+
 				if count > 0 && len(filtered) == 0 {
 					return fmt.Errorf("filter on sub-type=%s and name=%s returned no entries", healthSubType, healthName)
 				}
@@ -94,14 +105,18 @@ var getHealthCmd = &cobra.Command{
 				}
 			}
 
+			violations, err := displayRuleViolations(cmd, connection, dataFetcher)
+			if err != nil {
+				return err
+			}
+
 			// check to see if we should exit if we are not watching
 			if !isWatchEnabled() {
-				break
+				return getRuleViolationsError(violations)
 			}
 			// we are watching so sleep and then repeat until CTRL-C
 			time.Sleep(time.Duration(watchDelay) * time.Second)
 		}
-		return nil
 	},
 }
 
@@ -417,6 +432,7 @@ func init() {
 	getHealthCmd.Flags().StringVarP(&healthSubType, "sub-type", "s", all, "health sub-type")
 	getHealthCmd.Flags().StringVarP(&healthName, "name", "n", all, "health name")
 	getHealthCmd.Flags().BoolVarP(&healthSummary, "summary", "S", false, "if true, returns a summary across nodes")
+	getHealthCmd.Flags().StringVarP(&clusterConnections, clustersOption, "", "", clustersDescription)
 
 	monitorHealthCmd.Flags().BoolVarP(&getNodeID, "node-id", "N", false, "if true, returns the node id using the current context")
 	monitorHealthCmd.Flags().BoolVarP(&ignoreNSErrors, "ignore-errors", "I", false, "if true, ignores nslookup errors")
@@ -87,7 +87,11 @@ var startJfrCmd = &cobra.Command{
 	Long: `The 'start jfr' command starts a Java Flight Recording all or selected members.
 You can specify either a node id or role. If you do not specify either, then the JFR will 
 be run for all members. The default duration is 60 seconds and you can specify a value
-of 0 to make the recording continuous.`,
+of 0 to make the recording continuous.
+If you specify --session, the recording is started and then stopped after the duration.
+For a manually created cluster the JFR files are written to the output directory, or the log
+directory for the cluster if no output directory is specified. Once there is a complete file for
+each member, the files are copied into a timestamped directory and a summary is displayed.`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
 			displayErrorAndExit(cmd, provideJFR)
@@ -112,6 +116,10 @@ of 0 to make the recording continuous.`,
 			return errors.New("duration must be greater or equal to zero")
 		}
 
+		if jfrSessionParam && duration == 0 {
+			return errors.New("duration must be greater than zero for a JFR session")
+		}
+
 		connection, dataFetcher, err = GetConnectionAndDataFetcher()
 		if err != nil {
 			return err
@@ -156,6 +164,10 @@ of 0 to make the recording continuous.`,
 			return nil
 		}
 
+		if jfrSessionParam {
+			return runJFRSession(cmd, dataFetcher, connection, jfrName, jfrType, target)
+		}
+
 		data, err = dataFetcher.StartJFR(jfrName, outputDirectory, jfrType, target, duration, settingsFile)
 		if err != nil {
 			return err
@@ -373,6 +385,8 @@ func init() {
 	startJfrCmd.Flags().StringVarP(&settingsFile, "settings-file", "s", "default", "settings file to use, options are \"profile\" or a specific file")
 	startJfrCmd.Flags().StringVarP(&NodeID, "node", "n", "", nodeIDDesc)
 	startJfrCmd.Flags().Int32VarP(&duration, "duration", "D", 60, "duration for JFR in seconds. Use 0 for continuous")
+	startJfrCmd.Flags().BoolVarP(&jfrSessionParam, "session", "", false, "stop the JFR after the duration and copy the JFR files for a manually created cluster")
+	startJfrCmd.Flags().StringVarP(&jfrDownloadDirParam, "download-dir", "", ".", "directory to copy the JFR files to for a session")
 
 	stopJfrCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
 	stopJfrCmd.Flags().StringVarP(&NodeID, "node", "n", "", nodeIDDesc)
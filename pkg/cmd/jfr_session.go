@@ -0,0 +1,265 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	jfrSessionParam     bool
+	jfrDownloadDirParam string
+)
+
+const jfrFileWaitSeconds = 30
+
+// jfrFilePollInterval is the interval to check the JFR files are complete.
+var jfrFilePollInterval = time.Second
+
+// jfrRecording is a JFR file copied from a member at the end of a session.
+type jfrRecording struct {
+	MemberID string `json:"memberId"`
+	FileName string `json:"fileName"`
+	Size     int64  `json:"size"`
+	Path     string `json:"path"`
+}
+
+// runJFRSession starts a continuous JFR, stops it after the duration and, for a manually created
+// cluster, copies the JFR files for each targeted member into a timestamped directory. If an output
+// directory is not specified for a manually created cluster, the JFR files are written to the log
+// directory for the cluster.
+func runJFRSession(cmd *cobra.Command, dataFetcher fetcher.Fetcher, connection, jfrName, jfrType, target string) error {
+	var (
+		startTime = time.Now().Add(-time.Second)
+		directory = outputDirectory
+	)
+
+	found, clusterConnection := getConnection(connection)
+	manuallyCreated := found && clusterConnection.ManuallyCreated
+
+	if manuallyCreated && directory == "" {
+		logsDir := logsDirectory
+		if clusterConnection.LoggingDestination != "" {
+			logsDir = clusterConnection.LoggingDestination
+		}
+		directory = filepath.Join(logsDir, clusterConnection.Name)
+	}
+
+	members, err := getRollingMembers(dataFetcher)
+	if err != nil {
+		return err
+	}
+	memberIDs := getJFRSessionMembers(members, jfrType, target)
+
+	data, err := dataFetcher.StartJFR(jfrName, directory, jfrType, target, 0, settingsFile)
+	if err != nil {
+		return err
+	}
+
+	result, err := getJFResults(jfrType, data)
+	if err != nil {
+		return err
+	}
+	cmd.Println(result)
+
+	cmd.Printf("Recording for %d seconds...\n", duration)
+	time.Sleep(time.Duration(duration) * time.Second)
+
+	if data, err = dataFetcher.StopJFR(jfrName, jfrType, target); err != nil {
+		return err
+	}
+
+	if result, err = getJFResults(jfrType, data); err != nil {
+		return err
+	}
+
+	if !manuallyCreated {
+		cmd.Println(formatJFROutput(result))
+		cmd.Printf("Cluster %s was not manually created, the JFR files are in %s on each server\n", connection, outputDirectory)
+		return nil
+	}
+
+	files, err := waitForJFRFiles(directory, jfrName, startTime, memberIDs)
+	if err != nil {
+		cmd.Println(formatJFROutput(result))
+		return err
+	}
+
+	destination := filepath.Join(jfrDownloadDirParam, fmt.Sprintf("%s-%s", jfrName, time.Now().Format("20060102-150405")))
+	recordings, err := copyJFRFiles(files, destination, jfrName)
+	if err != nil {
+		return err
+	}
+
+	if isJSONPathOrJSON() {
+		jsonData, err := json.Marshal(recordings)
+		if err != nil {
+			return err
+		}
+		return processJSONOutput(cmd, jsonData)
+	}
+
+	cmd.Println(FormatJFRRecordings(recordings))
+	cmd.Printf("Copied %d JFR files to %s\n", len(recordings), destination)
+
+	return nil
+}
+
+// getJFRSessionMembers returns the node ids of the members targeted by a JFR.
+func getJFRSessionMembers(members []config.Member, jfrType, target string) []string {
+	var memberIDs = make([]string, 0)
+
+	for _, m := range members {
+		if (jfrType == fetcher.JfrTypeNode && m.NodeID != target) || (jfrType == fetcher.JfrTypeRole && m.RoleName != target) {
+			continue
+		}
+		memberIDs = append(memberIDs, m.NodeID)
+	}
+
+	return memberIDs
+}
+
+// waitForJFRFiles waits until there is a JFR file for each of the members and the sizes of the files
+// have stopped changing, and returns the files. If this does not happen within jfrFileWaitSeconds an
+// error is returned listing any members that do not have a JFR file.
+func waitForJFRFiles(directory, jfrName string, after time.Time, memberIDs []string) ([]string, error) {
+	var previousSizes map[string]int64
+
+	for i := 0; ; i++ {
+		files, sizes, err := findJFRFiles(directory, jfrName, after)
+		if err != nil {
+			return nil, err
+		}
+
+		missing := make([]string, 0)
+		for _, member := range memberIDs {
+			if size, ok := sizes[member]; !ok || size == 0 {
+				missing = append(missing, member)
+			}
+		}
+
+		if len(missing) == 0 && maps.Equal(sizes, previousSizes) {
+			return files, nil
+		}
+
+		if i >= jfrFileWaitSeconds {
+			if len(missing) > 0 {
+				return nil, fmt.Errorf("unable to find JFR files for %s in %s for members %v", jfrName, directory, missing)
+			}
+			return nil, fmt.Errorf("the JFR files for %s in %s were still being written after %d seconds", jfrName, directory, jfrFileWaitSeconds)
+		}
+
+		previousSizes = sizes
+		time.Sleep(jfrFilePollInterval)
+	}
+}
+
+// findJFRFiles returns the JFR files for a recording in the directory which were modified after the
+// given time, along with the size of the file for each member. Subdirectories are not searched.
+func findJFRFiles(directory, jfrName string, after time.Time) ([]string, map[string]int64, error) {
+	var (
+		files = make([]string, 0)
+		sizes = make(map[string]int64)
+	)
+
+	directory, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return files, sizes, nil
+		}
+		return nil, nil, err
+	}
+
+	for _, entry := range entries {
+		member := getJFRFileMember(entry.Name(), jfrName)
+		if entry.IsDir() || member == "" {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, nil, err
+		}
+		if info.ModTime().After(after) {
+			files = append(files, filepath.Join(directory, entry.Name()))
+			sizes[member] = info.Size()
+		}
+	}
+
+	return files, sizes, nil
+}
+
+// getJFRFileMember returns the member id from a JFR file name of the form member-name.jfr,
+// or an empty string if the file is not for the recording.
+func getJFRFileMember(fileName, jfrName string) string {
+	member, found := strings.CutSuffix(fileName, "-"+jfrName+".jfr")
+	if !found || !utils.IsValidInt(member) {
+		return ""
+	}
+	return member
+}
+
+// copyJFRFiles copies the JFR files to the destination directory and returns the recordings.
+func copyJFRFiles(files []string, destination, jfrName string) ([]jfrRecording, error) {
+	recordings := make([]jfrRecording, 0)
+
+	if err := os.MkdirAll(destination, 0750); err != nil {
+		return nil, utils.GetError("unable to create directory "+destination, err)
+	}
+
+	for _, file := range files {
+		fileName := filepath.Base(file)
+		target := filepath.Join(destination, fileName)
+
+		size, err := copyFile(file, target)
+		if err != nil {
+			return nil, utils.GetError("unable to copy "+file, err)
+		}
+
+		recordings = append(recordings, jfrRecording{MemberID: getJFRFileMember(fileName, jfrName),
+			FileName: fileName, Size: size, Path: target})
+	}
+
+	sort.Slice(recordings, func(p, q int) bool {
+		return recordings[p].FileName < recordings[q].FileName
+	})
+
+	return recordings, nil
+}
+
+// copyFile copies a file and returns the number of bytes copied.
+func copyFile(source, target string) (int64, error) {
+	in, err := os.Open(filepath.Clean(source))
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	out, err := os.Create(filepath.Clean(target))
+	if err != nil {
+		return 0, err
+	}
+	defer out.Close()
+
+	return io.Copy(out, in)
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestJFRSessionFiles(t *testing.T) {
+	var (
+		g           = gomega.NewGomegaWithT(t)
+		outputDir   = t.TempDir()
+		destination = filepath.Join(t.TempDir(), "test-1-20261017-101010")
+		startTime   = time.Now().Add(-time.Minute)
+	)
+
+	g.Expect(getJFRFileMember("2-test-1.jfr", "test-1")).To(gomega.Equal("2"))
+	g.Expect(getJFRFileMember("test-1.jfr", "test-1")).To(gomega.Equal(""))
+	g.Expect(getJFRFileMember("2-test-10.jfr", "test-1")).To(gomega.Equal(""))
+
+	g.Expect(os.WriteFile(filepath.Join(outputDir, "2-test-1.jfr"), []byte("member2"), 0600)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(outputDir, "1-test-1.jfr"), []byte("member-1"), 0600)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(outputDir, "2-other.jfr"), []byte("other"), 0600)).To(gomega.Succeed())
+
+	// subdirectories are not searched
+	g.Expect(os.MkdirAll(filepath.Join(outputDir, "member4"), 0750)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(outputDir, "member4", "4-test-1.jfr"), []byte("member-4"), 0600)).To(gomega.Succeed())
+
+	// an old recording with the same name is ignored
+	oldFile := filepath.Join(outputDir, "3-test-1.jfr")
+	g.Expect(os.WriteFile(oldFile, []byte("old"), 0600)).To(gomega.Succeed())
+	g.Expect(os.Chtimes(oldFile, startTime.Add(-time.Hour), startTime.Add(-time.Hour))).To(gomega.Succeed())
+
+	files, sizes, err := findJFRFiles(outputDir, "test-1", startTime)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(files)).To(gomega.Equal(2))
+	g.Expect(sizes).To(gomega.Equal(map[string]int64{"1": 8, "2": 7}))
+
+	files, _, err = findJFRFiles(filepath.Join(outputDir, "missing"), "test-1", startTime)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(files).To(gomega.BeEmpty())
+
+	recordings, err := copyJFRFiles([]string{filepath.Join(outputDir, "2-test-1.jfr"), filepath.Join(outputDir, "1-test-1.jfr")}, destination, "test-1")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(recordings).To(gomega.Equal([]jfrRecording{
+		{MemberID: "1", FileName: "1-test-1.jfr", Size: 8, Path: filepath.Join(destination, "1-test-1.jfr")},
+		{MemberID: "2", FileName: "2-test-1.jfr", Size: 7, Path: filepath.Join(destination, "2-test-1.jfr")},
+	}))
+	g.Expect(filepath.Join(destination, "2-test-1.jfr")).To(gomega.BeARegularFile())
+}
+
+func TestWaitForJFRFiles(t *testing.T) {
+	var (
+		g         = gomega.NewGomegaWithT(t)
+		outputDir = t.TempDir()
+		startTime = time.Now().Add(-time.Minute)
+		members   = []config.Member{{NodeID: "1", RoleName: "Proxy"}, {NodeID: "2", RoleName: "Storage"}, {NodeID: "3", RoleName: "Storage"}}
+	)
+
+	defer func(interval time.Duration) { jfrFilePollInterval = interval }(jfrFilePollInterval)
+	jfrFilePollInterval = time.Millisecond
+
+	g.Expect(getJFRSessionMembers(members, fetcher.JfrTypeCluster, "")).To(gomega.Equal([]string{"1", "2", "3"}))
+	g.Expect(getJFRSessionMembers(members, fetcher.JfrTypeRole, "Storage")).To(gomega.Equal([]string{"2", "3"}))
+	g.Expect(getJFRSessionMembers(members, fetcher.JfrTypeNode, "1")).To(gomega.Equal([]string{"1"}))
+
+	g.Expect(os.WriteFile(filepath.Join(outputDir, "2-test-1.jfr"), []byte("member2"), 0600)).To(gomega.Succeed())
+
+	// the file for member 3 is missing
+	_, err := waitForJFRFiles(outputDir, "test-1", startTime, []string{"2", "3"})
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("for members [3]")))
+
+	g.Expect(os.WriteFile(filepath.Join(outputDir, "3-test-1.jfr"), []byte("member3"), 0600)).To(gomega.Succeed())
+	files, err := waitForJFRFiles(outputDir, "test-1", startTime, []string{"2", "3"})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(files)).To(gomega.Equal(2))
+}
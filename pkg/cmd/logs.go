@@ -7,18 +7,95 @@
 package cmd
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"github.com/fatih/color"
+	"github.com/oracle/coherence-cli/pkg/utils"
 	"github.com/spf13/cobra"
+	"io"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
+const (
+	logTimestampFormat = "2006-01-02 15:04:05.000"
+	logFollowInterval  = 500 * time.Millisecond
+)
+
+var (
+	followLogsParam  bool
+	logRegexParam    string
+	logLevelFilter   string
+	logSinceParam    string
+	logUntilParam    string
+	logMembersParam  string
+	logTailLineParam int32
+
+	// logLevelRegex matches the Coherence log level, e.g. <Info>, <Warning> or <D5>, after the timestamp
+	logLevelRegex = regexp.MustCompile(`<(Fatal|Error|Warning|Info|D[4-9])>`)
+
+	memberColors = []color.Attribute{color.FgCyan, color.FgGreen, color.FgMagenta, color.FgBlue,
+		color.FgHiYellow, color.FgHiCyan, color.FgHiGreen, color.FgHiMagenta, color.FgHiBlue}
+
+	// logLevels maps the Coherence log level names to their numeric log level
+	logLevels = map[string]int{"fatal": 0, "error": 1, "warning": 2, "info": 3,
+		"d4": 4, "d5": 5, "d6": 6, "d7": 7, "d8": 8, "d9": 9}
+)
+
+// logFilter describes the filtering to apply to member log files.
+type logFilter struct {
+	regex *regexp.Regexp
+	level int // -1 indicates all levels
+	since time.Time
+	until time.Time
+}
+
+// memberLog describes a log file for a member of a cluster.
+type memberLog struct {
+	member   string
+	fileName string
+	prefix   string
+}
+
+// logEntry is an individual log message from a member, including any continuation lines.
+type logEntry struct {
+	member    *memberLog
+	timestamp time.Time
+	lines     []string
+}
+
+// memberLogReader filters lines from a member log file, tracking the current log entry so
+// continuation lines such as stack traces are filtered along with the line they belong to.
+type memberLogReader struct {
+	log           *memberLog
+	filter        logFilter
+	entryMatches  bool // the current entry matches the level and time window
+	headerMatches bool // the first line of the current entry matches the regular expression
+}
+
 // getLogsCmd represents the get logs command.
 var getLogsCmd = &cobra.Command{
-	Use:   "logs",
-	Short: "display the current 'cohctl' log file contents",
-	Long:  `The 'get logs' command displays the current contents of the 'cohctl' log file.`,
-	Args:  cobra.ExactArgs(0),
-	RunE: func(cmd *cobra.Command, _ []string) error {
+	Use:   "logs [cluster-name]",
+	Short: "display the current 'cohctl' log file contents or the member logs for a cluster",
+	Long: `The 'get logs' command displays the current contents of the 'cohctl' log file. If a cluster name
+of a manually created cluster is specified, then the log files for all members are displayed, merged
+by timestamp and prefixed by the member name. Use '-f' to follow the logs, '--regex' to only display
+matching lines, '--level' to only display messages of the level or more severe, and '--since' and
+'--until' to select a time window. Levels are fatal, error, warning, info and d4 to d9 or 0 to 9.
+Times can be a duration before now such as 10m, or a timestamp such as '2026-01-01 10:00:00'.`,
+	ValidArgsFunction: completionAllManualClusters,
+	Args:              cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 1 {
+			return displayMemberLogs(cmd, args[0])
+		}
+
 		data, err := os.ReadFile(logFilePath)
 		if err != nil {
 			return fmt.Errorf("unable to display logfile %s: %v", logFilePath, err)
@@ -27,3 +104,353 @@ var getLogsCmd = &cobra.Command{
 		return nil
 	},
 }
+
+// displayMemberLogs displays the member logs for a manually created cluster.
+func displayMemberLogs(cmd *cobra.Command, connectionName string) error {
+	found, connection := GetClusterConnection(connectionName)
+	if !found {
+		return errors.New(UnableToFindClusterMsg + connectionName)
+	}
+
+	if err := checkOperation(connection, "get logs"); err != nil {
+		return err
+	}
+
+	filter, err := getLogFilter(time.Now())
+	if err != nil {
+		return err
+	}
+
+	// if the logging destination has been set on the connection then use it
+	if connection.LoggingDestination != "" {
+		logsDirectory = connection.LoggingDestination
+	}
+
+	logs, err := getMemberLogs(filepath.Join(logsDirectory, connection.Name), logMembersParam)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]logEntry, 0)
+	offsets := make([]int64, len(logs))
+	for i := range logs {
+		var memberEntries []logEntry
+		memberEntries, offsets[i], err = readMemberLog(&logs[i], filter)
+		if err != nil {
+			return err
+		}
+		if logTailLineParam > 0 && len(memberEntries) > int(logTailLineParam) {
+			memberEntries = memberEntries[len(memberEntries)-int(logTailLineParam):]
+		}
+		entries = append(entries, memberEntries...)
+	}
+
+	// merge the entries from all members by timestamp
+	sort.SliceStable(entries, func(p, q int) bool {
+		return entries[p].timestamp.Before(entries[q].timestamp)
+	})
+
+	for _, e := range entries {
+		printLogEntry(cmd, e)
+	}
+
+	if !followLogsParam {
+		return nil
+	}
+
+	return followMemberLogs(cmd, logs, offsets, filter)
+}
+
+// getLogFilter returns the log filter from the flags.
+func getLogFilter(now time.Time) (logFilter, error) {
+	var (
+		filter = logFilter{level: -1}
+		err    error
+	)
+
+	if logRegexParam != "" {
+		if filter.regex, err = regexp.Compile(logRegexParam); err != nil {
+			return filter, fmt.Errorf("invalid regular expression %s: %v", logRegexParam, err)
+		}
+	}
+
+	if logLevelFilter != "" {
+		if filter.level, err = parseLogLevel(logLevelFilter); err != nil {
+			return filter, err
+		}
+	}
+
+	if filter.since, err = parseLogTime(logSinceParam, now); err != nil {
+		return filter, err
+	}
+
+	if filter.until, err = parseLogTime(logUntilParam, now); err != nil {
+		return filter, err
+	}
+
+	if !filter.since.IsZero() && !filter.until.IsZero() && filter.until.Before(filter.since) {
+		return filter, errors.New("the until time must be after the since time")
+	}
+
+	return filter, nil
+}
+
+// parseLogLevel parses a log level name or number.
+func parseLogLevel(value string) (int, error) {
+	if level, ok := logLevels[strings.ToLower(value)]; ok {
+		return level, nil
+	}
+	if level, err := strconv.Atoi(value); err == nil && level >= 0 && level <= 9 {
+		return level, nil
+	}
+	return -1, fmt.Errorf("invalid log level %s, must be one of fatal, error, warning, info, d4-d9 or 0-9", value)
+}
+
+// parseLogTime parses a duration before now or a timestamp in local time.
+func parseLogTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(-duration), nil
+	}
+	for _, layout := range []string{logTimestampFormat, time.DateTime, "2006-01-02 15:04", time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %s, must be a duration such as 10m or a timestamp such as '2006-01-02 15:04:05'", value)
+}
+
+// getLogLineTime returns the timestamp at the start of a log line, or false if the
+// line does not start with a timestamp and is a continuation of the previous line.
+func getLogLineTime(line string) (time.Time, bool) {
+	if len(line) < len(logTimestampFormat) {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation(logTimestampFormat, line[:len(logTimestampFormat)], time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// getLogLineLevel returns the Coherence log level for a log line or -1 if it cannot be determined.
+func getLogLineLevel(line string) int {
+	match := logLevelRegex.FindStringSubmatch(line)
+	if match == nil {
+		return -1
+	}
+	return logLevels[strings.ToLower(match[1])]
+}
+
+// entryMatches returns true if a log entry, starting with the line, matches the level and time window.
+func (f logFilter) entryMatches(line string, timestamp time.Time) bool {
+	if !f.since.IsZero() && timestamp.Before(f.since) {
+		return false
+	}
+	if !f.until.IsZero() && timestamp.After(f.until) {
+		return false
+	}
+	if f.level != -1 {
+		level := getLogLineLevel(line)
+		if level == -1 || level > f.level {
+			return false
+		}
+	}
+	return true
+}
+
+// getMemberLogs returns the member log files in the directory, optionally restricted to a comma separated list of members.
+func getMemberLogs(directory, members string) ([]memberLog, error) {
+	files, err := filepath.Glob(filepath.Join(directory, "*.log"))
+	if err != nil {
+		return nil, err
+	}
+
+	var memberNames = make([]string, 0)
+	if members != "" {
+		for _, v := range strings.Split(members, ",") {
+			memberNames = append(memberNames, strings.TrimSpace(v))
+		}
+	}
+
+	logs := make([]memberLog, 0)
+	for _, f := range files {
+		member := strings.TrimSuffix(filepath.Base(f), ".log")
+		if len(memberNames) > 0 && !utils.SliceContains(memberNames, member) {
+			continue
+		}
+		logs = append(logs, memberLog{member: member, fileName: f})
+	}
+
+	if len(logs) == 0 {
+		return nil, fmt.Errorf("no member log files found in %s", directory)
+	}
+
+	// pad the prefixes so the log messages line up and apply a color per member
+	width := 0
+	for _, l := range logs {
+		if len(l.member) > width {
+			width = len(l.member)
+		}
+	}
+	useColor := Config.Color == on && !isWindows() && !color.NoColor
+	for i := range logs {
+		prefix := fmt.Sprintf("%-*s |", width, logs[i].member)
+		if useColor {
+			prefix = color.New(memberColors[i%len(memberColors)]).Sprint(prefix)
+		}
+		logs[i].prefix = prefix
+	}
+
+	return logs, nil
+}
+
+// newMemberLogReader returns a reader for a member log. Lines before the first log entry, such as
+// JVM output, are only included if no level or time window has been specified.
+func newMemberLogReader(log *memberLog, filter logFilter) *memberLogReader {
+	return &memberLogReader{log: log, filter: filter, headerMatches: filter.regex == nil,
+		entryMatches: filter.level == -1 && filter.since.IsZero() && filter.until.IsZero()}
+}
+
+// filterLine returns true if the line should be included and, if the line starts a new entry, its timestamp.
+func (r *memberLogReader) filterLine(line string) (bool, time.Time, bool) {
+	timestamp, newEntry := getLogLineTime(line)
+	if newEntry {
+		r.entryMatches = r.filter.entryMatches(line, timestamp)
+		r.headerMatches = r.filter.regex == nil || r.filter.regex.MatchString(line)
+		return r.entryMatches && r.headerMatches, timestamp, true
+	}
+
+	// continuation line for the current entry
+	include := r.entryMatches && (r.headerMatches || r.filter.regex.MatchString(line))
+	return include, timestamp, false
+}
+
+// readLines reads complete lines from the input, calling the function for each
+// line, and returns the number of bytes consumed.
+func readLines(input io.Reader, lineFunc func(line string)) int64 {
+	var (
+		consumed int64
+		reader   = bufio.NewReader(input)
+	)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// incomplete lines are read again next time
+			return consumed
+		}
+		consumed += int64(len(line))
+		lineFunc(strings.TrimRight(line, "\r\n"))
+	}
+}
+
+// readMemberLog reads the matching entries from a member log file and returns the offset read to.
+func readMemberLog(log *memberLog, filter logFilter) ([]logEntry, int64, error) {
+	var (
+		entries = make([]logEntry, 0)
+		current *logEntry
+		reader  = newMemberLogReader(log, filter)
+	)
+
+	file, err := os.Open(log.fileName)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to open log file %s: %v", log.fileName, err)
+	}
+	defer file.Close()
+
+	flush := func() {
+		if current != nil && len(current.lines) > 0 {
+			entries = append(entries, *current)
+		}
+		current = nil
+	}
+
+	offset := readLines(file, func(line string) {
+		include, timestamp, newEntry := reader.filterLine(line)
+		if newEntry {
+			flush()
+			current = &logEntry{member: log, timestamp: timestamp}
+		}
+		if include {
+			if current == nil {
+				current = &logEntry{member: log}
+			}
+			current.lines = append(current.lines, line)
+		}
+	})
+	flush()
+
+	return entries, offset, nil
+}
+
+// followMemberLogs polls the member log files and displays new lines until the command is interrupted.
+func followMemberLogs(cmd *cobra.Command, logs []memberLog, offsets []int64, filter logFilter) error {
+	readers := make([]*memberLogReader, len(logs))
+	for i := range logs {
+		readers[i] = newMemberLogReader(&logs[i], filter)
+	}
+
+	for {
+		for i := range logs {
+			stat, err := os.Stat(logs[i].fileName)
+			if err != nil {
+				continue
+			}
+
+			// the log file is recreated when a member is restarted
+			if stat.Size() < offsets[i] {
+				offsets[i] = 0
+			}
+			if stat.Size() == offsets[i] {
+				continue
+			}
+
+			consumed, err := followMemberLog(cmd, logs[i], offsets[i], readers[i])
+			if err != nil {
+				continue
+			}
+			offsets[i] += consumed
+		}
+
+		time.Sleep(logFollowInterval)
+	}
+}
+
+// followMemberLog displays the matching lines written to a log file since the offset.
+func followMemberLog(cmd *cobra.Command, log memberLog, offset int64, reader *memberLogReader) (int64, error) {
+	file, err := os.Open(log.fileName)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return readLines(file, func(line string) {
+		if include, _, _ := reader.filterLine(line); include {
+			cmd.Printf("%s %s\n", log.prefix, line)
+		}
+	}), nil
+}
+
+// printLogEntry prints the lines of a log entry prefixed by the member name.
+func printLogEntry(cmd *cobra.Command, entry logEntry) {
+	for _, line := range entry.lines {
+		cmd.Printf("%s %s\n", entry.member.prefix, line)
+	}
+}
+
+func init() {
+	getLogsCmd.Flags().BoolVarP(&followLogsParam, "follow", "f", false, "follow the member log files")
+	getLogsCmd.Flags().StringVarP(&logRegexParam, "regex", "", "", "only display lines matching the regular expression")
+	getLogsCmd.Flags().StringVarP(&logLevelFilter, "level", "", "", "only display messages of this level or more severe")
+	getLogsCmd.Flags().StringVarP(&logSinceParam, "since", "", "", "only display messages after this time or duration before now")
+	getLogsCmd.Flags().StringVarP(&logUntilParam, "until", "", "", "only display messages before this time or duration before now")
+	getLogsCmd.Flags().StringVarP(&logMembersParam, "members", "", "", "comma separated list of members to display logs for")
+	getLogsCmd.Flags().Int32VarP(&logTailLineParam, "tail", "t", 0, "only display the last number of messages for each member")
+}
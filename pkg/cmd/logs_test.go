@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+)
+
+const testMemberLog = `Picked up JAVA_TOOL_OPTIONS
+2026-03-26 08:11:00.537/1.234 Oracle Coherence CE 22.06 <Info> (thread=main, member=n/a): Started cluster
+2026-03-26 08:11:01.100/1.800 Oracle Coherence CE 22.06 <Error> (thread=main, member=1): Partition transfer failed
+java.lang.IllegalStateException: failed
+	at com.example.Test.run(Test.java:10)
+2026-03-26 08:11:02.200/2.900 Oracle Coherence CE 22.06 <D5> (thread=main, member=1): Transferring partition 12
+2026-03-26 08:11:03.300/3.000 Oracle Coherence CE 22.06 <Warning> (thread=main, member=1): Partition 12 endangered
+`
+
+func TestMemberLogFiltering(t *testing.T) {
+	var (
+		g   = gomega.NewGomegaWithT(t)
+		dir = t.TempDir()
+	)
+
+	g.Expect(os.WriteFile(filepath.Join(dir, "storage-0.log"), []byte(testMemberLog), 0600)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "storage-1.log"), []byte(testMemberLog), 0600)).To(gomega.Succeed())
+
+	logs, err := getMemberLogs(dir, "storage-1")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(logs)).To(gomega.Equal(1))
+
+	logs, err = getMemberLogs(dir, "")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(logs)).To(gomega.Equal(2))
+
+	// no filter includes all lines
+	entries, offset, err := readMemberLog(&logs[0], logFilter{level: -1})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(offset).To(gomega.Equal(int64(len(testMemberLog))))
+	g.Expect(len(entries)).To(gomega.Equal(5))
+
+	// warning or more severe includes the stack trace for the error
+	entries, _, _ = readMemberLog(&logs[0], logFilter{level: 2})
+	g.Expect(len(entries)).To(gomega.Equal(2))
+	g.Expect(len(entries[0].lines)).To(gomega.Equal(3))
+
+	// regex only includes matching lines
+	entries, _, _ = readMemberLog(&logs[0], logFilter{level: -1, regex: regexp.MustCompile("partition 12|Partition 12")})
+	g.Expect(len(entries)).To(gomega.Equal(2))
+
+	// time window
+	since, _ := parseLogTime("2026-03-26 08:11:02", time.Now())
+	entries, _, _ = readMemberLog(&logs[0], logFilter{level: -1, since: since})
+	g.Expect(len(entries)).To(gomega.Equal(2))
+
+	level, err := parseLogLevel("Warning")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(level).To(gomega.Equal(2))
+	_, err = parseLogLevel("verbose")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	now := time.Now()
+	since, err = parseLogTime("10m", now)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(since).To(gomega.Equal(now.Add(-10 * time.Minute)))
+	_, err = parseLogTime("yesterday", now)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -64,9 +64,13 @@ var getMembersCmd = &cobra.Command{
 	Use:   "members",
 	Short: "display members for a cluster",
 	Long: `The 'get members' command displays the members for a cluster. You
-can specify '-o wide' to display addition information.`,
+can specify '-o wide' to display addition information. Specify a comma separated list of
+connections, or 'all', using '--clusters' to display the members of multiple clusters.`,
 	Args: cobra.ExactArgs(0),
 	RunE: func(cmd *cobra.Command, _ []string) error {
+		if clusterConnections != "" {
+			return runMultiClusterCommand(cmd, retrieveClusterMembers, FormatMultiClusterMembers, false)
+		}
 		return getMembers(cmd, false)
 	},
 }
@@ -155,6 +159,10 @@ func getMembers(cmd *cobra.Command, networkStats bool) error {
 				copy(filteredMembers, members.Members)
 			}
 
+			if err = recordWatchSample(filteredMembers); err != nil {
+				return err
+			}
+
 			if networkStats {
 				cmd.Println(FormatNetworkStatistics(filteredMembers))
 			} else {
@@ -1049,6 +1057,9 @@ a role to retrieve thread dumps for.`,
 
 		if len(errorList) == 0 {
 			cmd.Println("\nAll thread dumps completed and written to " + outputDirectory)
+			if analyzeThreadDumps {
+				return analyzeRetrievedThreadDumps(cmd, nodeIDs)
+			}
 		} else if len(errorList) == 1 {
 			return errorList[0]
 		} else {
@@ -1135,6 +1146,7 @@ func init() {
 	getMembersCmd.Flags().BoolVarP(&memberSummary, "summary", "S", false, "show a member summary")
 	getMembersCmd.Flags().BoolVarP(&showMembersOnly, "members", "M", false, "show members only")
 	getMembersCmd.Flags().BoolVarP(&departedMembers, "departed", "D", false, "show departed members only")
+	getMembersCmd.Flags().StringVarP(&clusterConnections, clustersOption, "", "", clustersDescription)
 
 	getNetworkStatsCmd.Flags().StringVarP(&roleName, "role", "r", all, roleNameDescription)
 
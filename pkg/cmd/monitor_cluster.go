@@ -17,6 +17,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"log"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
@@ -82,6 +83,10 @@ var (
 	noContentArray = []string{"  ", noContent, " "}
 	drawnPositions map[rune]position
 
+	// rules evaluated on each refresh
+	monitorRules       []parsedRule
+	lastRuleViolations []RuleViolation
+
 	// color styles
 	boxStyle   = tcell.StyleDefault
 	titleStyle = tcell.StyleDefault
@@ -177,6 +182,7 @@ var validPanels = []panelImpl{
 	createContentPanel(7, "cache-storage", "Cache Storage (%SERVICE/%CACHE)", "show cache storage", cacheStorageContent, cachesPanelData, servicesPanelData),
 	createContentPanel(7, "cache-stores", "Cache Stores (%SERVICE/%CACHE)", "show cache stores", cacheStoresContent),
 	createContentPanel(7, "cache-partitions", "Cache Partitions (%SERVICE/%CACHE)", "show cache partitions", cachePartitionContent, cachesPanelData, servicesPanelData),
+	createContentPanel(7, "clusters-overview", "Clusters Overview", "show multi-cluster overview", clustersOverviewContent),
 	createContentPanel(7, "departed-members", "Departed Members", "show departed members", departedMembersContent, memberPanelData, storagePanelData),
 	createContentPanel(4, "elastic-data", "Elastic Data", "show elastic data", elasticDataContent, elasticDataPanelData),
 	createContentPanel(7, "executors", "Executors", "show Executors", executorsContent, executorsPanelData),
@@ -231,10 +237,13 @@ You can specify a layout to show by providing a value for '-l'. Panels can be sp
 Specifying a ':' is the line separator and ',' means panels on the same line. If you don't specify one the 'default' layout is used.
 There are a number of layouts available: 'default-service', 'default-cache', 'default-topic' and 'default-subscriber' which 
 require you to specify cache, service, topic or subscriber.
-Use --show-panels to show all available panels.`,
+Use --show-panels to show all available panels. Use --record-samples to save each refresh to a
+directory, and --playback to step through the recorded samples instead of connecting to a cluster. Any rules added using 'add rule' are evaluated
+on each refresh and panels containing violations are highlighted. The 'clusters-overview' panel shows
+the member count, StatusHA and health for the connections specified using --clusters, or all connections.`,
 	ValidArgsFunction: completionAllClusters,
 	Args: func(cmd *cobra.Command, args []string) error {
-		if len(args) != 1 && (!showAllPanels && !previewStylesParam) {
+		if len(args) != 1 && (!showAllPanels && !previewStylesParam && playbackPath == "") {
 			displayErrorAndExit(cmd, youMustProviderConnectionMessage)
 		}
 		return nil
@@ -245,6 +254,7 @@ Use --show-panels to show all available panels.`,
 			dataFetcher  fetcher.Fetcher
 			err          error
 			parsedLayout []string
+			samples      []playbackSample
 		)
 
 		if showAllPanels {
@@ -256,21 +266,35 @@ Use --show-panels to show all available panels.`,
 			return previewAllStyles()
 		}
 
-		clusterName = args[0]
-
 		// set to tru to turn off incompatible color formatting
 		monitorCluster = true
 
 		setupColors()
 
-		found, _ := GetClusterConnection(clusterName)
-		if !found {
-			return errors.New(UnableToFindClusterMsg + clusterName)
-		}
+		if playbackPath != "" {
+			if recordSamplesDir != "" {
+				return errors.New("you cannot specify --record-samples when using --playback")
+			}
+			if samples, err = loadPlaybackSamples(playbackPath); err != nil {
+				return err
+			}
+			if dataFetcher, err = samples[0].getFetcher(); err != nil {
+				return err
+			}
+			// recorded samples may not contain data for all panels
+			ignoreRESTErrors = true
+		} else {
+			clusterName = args[0]
 
-		dataFetcher, err = GetDataFetcher(clusterName)
-		if err != nil {
-			return err
+			found, _ := GetClusterConnection(clusterName)
+			if !found {
+				return errors.New(UnableToFindClusterMsg + clusterName)
+			}
+
+			dataFetcher, err = GetDataFetcher(clusterName)
+			if err != nil {
+				return err
+			}
 		}
 
 		if err = setColorStyle(); err != nil {
@@ -307,11 +331,24 @@ Use --show-panels to show all available panels.`,
 
 		allBaseData = getAllBaseData(parsedLayout)
 
+		// ensure the data required for any rules is also retrieved
+		monitorRules, err = getParsedRules()
+		if err != nil {
+			return err
+		}
+		for _, b := range getRulesBaseData(monitorRules) {
+			if !utils.SliceContains(allBaseData, b) {
+				allBaseData = append(allBaseData, b)
+			}
+		}
+
 		// retrieve cluster details first so if we are connected
 		// to WLS or need authentication, this can be done first
-		_, err = dataFetcher.GetClusterDetailsJSON()
-		if err != nil {
-			return fmt.Errorf("unable to connect to cluster %s: %v", clusterName, err)
+		if playbackPath == "" {
+			_, err = dataFetcher.GetClusterDetailsJSON()
+			if err != nil {
+				return fmt.Errorf("unable to connect to cluster %s: %v", clusterName, err)
+			}
 		}
 
 		screen, err := tcell.NewScreen()
@@ -335,10 +372,14 @@ Use --show-panels to show all available panels.`,
 			}
 		}()
 
-		exit := make(chan struct{})
-
 		originalMaxHeight = setMaxHeight
 
+		if playbackPath != "" {
+			return playbackSamples(screen, samples, parsedLayout)
+		}
+
+		exit := make(chan struct{})
+
 		// initial update
 		err = updateScreen(screen, dataFetcher, parsedLayout, true)
 		if err != nil {
@@ -661,6 +702,10 @@ func updateScreen(screen tcell.Screen, dataFetcher fetcher.Fetcher, parsedLayout
 			screen.Show()
 			initialRefresh = false
 		}
+		if recordSamplesDir != "" {
+			// each refresh, including any panel content retrieved, is recorded as a new sample
+			fetcher.SetRecordDirectory(filepath.Join(recordSamplesDir, samplePrefix+startTime.Format(bundleTimestampFormat)))
+		}
 		lastClusterSummaryInfo, errorList = retrieveClusterSummary(dataFetcher, allBaseData...)
 		lastDuration = time.Since(startTime)
 
@@ -673,6 +718,13 @@ func updateScreen(screen tcell.Screen, dataFetcher fetcher.Fetcher, parsedLayout
 			err = utils.GetErrors(errorList)
 			return err
 		}
+
+		if len(monitorRules) > 0 {
+			lastRuleViolations, err = evaluateRules(monitorRules, lastClusterSummaryInfo, monitorCacheSizeSamples)
+			if err != nil && !ignoreRESTErrors {
+				return err
+			}
+		}
 	}
 
 	screen.Clear()
@@ -1608,15 +1660,68 @@ func drawContent(screen tcell.Screen, dataFetcher fetcher.Fetcher, panel panelIm
 		trimmedText = fmt.Sprintf("%v%s", string(tcell.RuneHLine), "(trimmed)")
 	}
 
-	drawBox(screen, x, y, x+w-1, y+h, boxStyle, fmt.Sprintf("%s[%v]%s", parseTitle(title), string(code), trimmedText))
+	// highlight any lines that contain targets of rule violations
+	var (
+		violationStyle = textStyle.Foreground(tcell.ColorRed)
+		highlighted    = make([]bool, rows)
+		violationCount = 0
+		panelBoxStyle  = boxStyle
+	)
+	for line := 0; line < rows; line++ {
+		if lineHasRuleViolation(panel, content[line]) {
+			highlighted[line] = true
+			violationCount++
+		}
+	}
+	if violationCount > 0 {
+		panelBoxStyle = violationStyle
+		trimmedText = fmt.Sprintf("%s%v(%d rule violations)", trimmedText, string(tcell.RuneHLine), violationCount)
+	}
+
+	drawBox(screen, x, y, x+w-1, y+h, panelBoxStyle, fmt.Sprintf("%s[%v]%s", parseTitle(title), string(code), trimmedText))
 
 	for line := 1; line <= rows; line++ {
-		drawText(screen, x+1, y+line, x+w-1, y+h-1, textStyle, content[line-1])
+		style := textStyle
+		if highlighted[line-1] {
+			style = violationStyle
+		}
+		drawText(screen, x+1, y+line, x+w-1, y+h-1, style, content[line-1])
 	}
 
 	return rows + 2, nil
 }
 
+// lineHasRuleViolation returns true if the line displayed in the panel contains a target of a rule violation.
+func lineHasRuleViolation(panel panelImpl, line string) bool {
+	if len(lastRuleViolations) == 0 || len(panel.BaseData) == 0 {
+		return false
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return false
+	}
+
+	switch panel.BaseData[0] {
+	case servicesPanelData:
+		for _, target := range getRuleViolationTargets(lastRuleViolations, ruleScopeSvc) {
+			if utils.SliceContains(fields, target) {
+				return true
+			}
+		}
+	case cachesPanelData:
+		for _, target := range getRuleViolationTargets(lastRuleViolations, ruleScopeCache) {
+			if s := strings.SplitN(target, "/", 2); utils.SliceContains(fields, s[0]) && utils.SliceContains(fields, s[1]) {
+				return true
+			}
+		}
+	case memberPanelData:
+		return utils.SliceContains(getRuleViolationTargets(lastRuleViolations, ruleScopeMember), fields[0])
+	}
+
+	return false
+}
+
 func parseTitle(title string) string {
 	s := strings.ReplaceAll(title, topicNameToken, selectedTopic)
 	s = strings.ReplaceAll(s, cacheNameToken, selectedCache)
@@ -1656,8 +1761,12 @@ func drawHeader(screen tcell.Screen, w, h int, cluster config.Cluster, dataFetch
 		} else if heightAdjust > 0 {
 			height = fmt.Sprintf("+%v ", heightAdjust)
 		}
+		displayTime := time.Now().Format(time.DateTime)
+		if playbackStatus != "" {
+			displayTime = playbackStatus
+		}
 		title = fmt.Sprintf("Coherence CLI: %s - %s (%s) ESC to quit %s. %s%s(%v)",
-			time.Now().Format(time.DateTime), cluster.ClusterName, version[0], additionalMonitorMsg, padding, height, lastDuration)
+			displayTime, cluster.ClusterName, version[0], additionalMonitorMsg, padding, height, lastDuration)
 		titleLen := len(title)
 		if titleLen < w-2 {
 			title = fmt.Sprintf("%s%-*s", title, w-titleLen-2, " ")
@@ -1782,4 +1891,7 @@ func init() {
 	monitorClusterCmd.Flags().IntVarP(&setMaxHeight, "max-height", "M", 0, "override max height for all panels")
 	monitorClusterCmd.Flags().BoolVarP(&ignoreSpecialCaches, "ignore-special", "", false, ignoreCachesDescription)
 	monitorClusterCmd.Flags().BoolVarP(&previewStylesParam, "preview-styles", "", false, "preview all the styles")
+	monitorClusterCmd.Flags().StringVarP(&recordSamplesDir, "record-samples", "", "", "directory to record each refresh to for later playback")
+	monitorClusterCmd.Flags().StringVarP(&playbackPath, "playback", "", "", "directory of recorded samples to play back")
+	monitorClusterCmd.Flags().StringVarP(&clusterConnections, clustersOption, "", "", "comma separated list of cluster connections to show in the clusters-overview panel")
 }
@@ -0,0 +1,362 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell/v2"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	samplePrefix       = "sample-"
+	playbackJumpPrompt = " Jump to time (HH:MM, HH:MM:SS or YYYY-MM-DD HH:MM:SS), ESC to cancel: "
+)
+
+var (
+	// recordSamplesDir is the directory to record each monitor refresh to
+	recordSamplesDir string
+
+	// playbackPath is the directory or archive of recorded samples to play back
+	playbackPath string
+
+	// playbackStatus is displayed in the header instead of the current time when playing back
+	playbackStatus string
+
+	playbackTimeFormats = []string{time.DateTime, "15:04:05", "15:04"}
+)
+
+// playbackSample is a single recorded refresh of the monitor cluster command.
+type playbackSample struct {
+	path string
+	time time.Time
+}
+
+// playbackState contains the current position when playing back samples. It is only
+// accessed from the event loop so does not require locking.
+type playbackState struct {
+	samples []playbackSample
+	index   int
+	paused  bool
+}
+
+// getFetcher returns a Fetcher which serves the responses recorded in the sample.
+func (p playbackSample) getFetcher() (fetcher.Fetcher, error) {
+	return fetcher.NewReplayFetcher(p.path, "", "")
+}
+
+// loadPlaybackSamples loads the samples from a directory created using --record-samples. A single
+// recording, such as one created using --record-responses, is loaded as one sample.
+func loadPlaybackSamples(path string) ([]playbackSample, error) {
+	var samples = make([]playbackSample, 0)
+
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	addSample := func(samplePath string) error {
+		manifest, err := fetcher.GetReplayManifest(samplePath)
+		if err != nil {
+			return err
+		}
+		recorded, err := time.Parse(time.RFC3339, manifest.Recorded)
+		if err != nil {
+			return utils.GetError("invalid recorded time in "+samplePath, err)
+		}
+		samples = append(samples, playbackSample{path: samplePath, time: recorded.Local()})
+		return nil
+	}
+
+	if !utils.DirectoryExists(path) || utils.FileExists(filepath.Join(path, fetcher.ManifestFileName)) {
+		if err = addSample(path); err != nil {
+			return nil, err
+		}
+		return samples, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), samplePrefix) {
+			if err = addSample(filepath.Join(path, entry.Name())); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no recorded samples found in %s", path)
+	}
+
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].time.Before(samples[j].time)
+	})
+
+	return samples, nil
+}
+
+// findSampleForTime returns the index of the first sample at or after the given time. If only
+// a time of day is provided, then it is relative to the date of the first sample.
+func findSampleForTime(samples []playbackSample, value string) (int, error) {
+	var (
+		target time.Time
+		err    error
+		first  = samples[0].time
+	)
+
+	value = strings.TrimSpace(value)
+	for _, format := range playbackTimeFormats {
+		if target, err = time.ParseInLocation(format, value, first.Location()); err == nil {
+			if format != time.DateTime {
+				target = time.Date(first.Year(), first.Month(), first.Day(), target.Hour(),
+					target.Minute(), target.Second(), 0, first.Location())
+				if target.Before(first.Truncate(time.Minute)) {
+					// time is earlier than the recording so assume the next day
+					target = target.AddDate(0, 0, 1)
+				}
+			}
+			break
+		}
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s", value)
+	}
+
+	for i, sample := range samples {
+		if !sample.time.Before(target) {
+			return i, nil
+		}
+	}
+
+	return len(samples) - 1, nil
+}
+
+// getStatus returns the status to display in the header.
+func (p *playbackState) getStatus() string {
+	state := "PLAYING"
+	if p.paused {
+		state = "PAUSED"
+	}
+	return fmt.Sprintf("%s [%d/%d %s]", p.samples[p.index].time.Format(time.DateTime), p.index+1, len(p.samples), state)
+}
+
+// move moves the current position by the delta and returns the fetcher for the new position.
+func (p *playbackState) move(delta int) (fetcher.Fetcher, error) {
+	return p.moveTo(p.index + delta)
+}
+
+// moveTo moves to the given position and returns the fetcher for the new position. The
+// previous sample is evicted from memory so only the current sample is loaded.
+func (p *playbackState) moveTo(index int) (fetcher.Fetcher, error) {
+	if index < 0 {
+		index = 0
+	} else if index >= len(p.samples) {
+		index = len(p.samples) - 1
+	}
+	if index != p.index {
+		fetcher.EvictReplayStore(p.samples[p.index].path)
+	}
+	p.index = index
+	playbackStatus = p.getStatus()
+	return p.samples[p.index].getFetcher()
+}
+
+// playbackSamples displays the recorded samples, advancing every delay seconds unless paused.
+// The timer posts an interrupt event so that all rendering is done from the event loop.
+func playbackSamples(screen tcell.Screen, samples []playbackSample, parsedLayout []string) error {
+	var (
+		state       = &playbackState{samples: samples}
+		exit        = make(chan struct{})
+		dataFetcher fetcher.Fetcher
+		err         error
+	)
+
+	defer func() {
+		playbackStatus = ""
+		fetcher.EvictReplayStore(state.samples[state.index].path)
+	}()
+
+	show := func(f fetcher.Fetcher, refresh bool) {
+		if f == nil {
+			return
+		}
+		dataFetcher = f
+		if err := updateScreen(screen, dataFetcher, parsedLayout, refresh); err != nil {
+			panic(err)
+		}
+	}
+
+	if dataFetcher, err = state.moveTo(0); err != nil {
+		return err
+	}
+	show(dataFetcher, true)
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(watchDelay) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-exit:
+				return
+			case <-ticker.C:
+				_ = screen.PostEvent(tcell.NewEventInterrupt(nil))
+			}
+		}
+	}()
+
+	for {
+		var f fetcher.Fetcher
+
+		ev := screen.PollEvent()
+		switch ev := ev.(type) {
+		case *tcell.EventResize:
+			show(dataFetcher, false)
+			screen.Sync()
+		case *tcell.EventInterrupt:
+			if !state.paused && state.index < len(state.samples)-1 {
+				f, err = state.move(1)
+			}
+		case *tcell.EventKey:
+			pressedKey := ev.Rune()
+			if (ev.Key() == tcell.KeyESC && expandedPanel == "") || ev.Key() == tcell.KeyCtrlC {
+				close(exit)
+				return nil
+			}
+			switch {
+			case ev.Key() == tcell.KeyRight:
+				f, err = state.move(1)
+			case ev.Key() == tcell.KeyLeft:
+				f, err = state.move(-1)
+			case ev.Key() == tcell.KeyHome:
+				f, err = state.move(-len(samples))
+			case ev.Key() == tcell.KeyEnd:
+				f, err = state.move(len(samples))
+			case pressedKey == ' ':
+				state.paused = !state.paused
+				playbackStatus = state.getStatus()
+				show(dataFetcher, false)
+			case pressedKey == '/':
+				state.paused = true
+				if value, ok := readPlaybackInput(screen, playbackJumpPrompt); ok {
+					if index, err1 := findSampleForTime(samples, value); err1 == nil {
+						f, err = state.moveTo(index)
+					}
+				}
+				if f == nil {
+					f, err = state.move(0)
+				}
+			case pressedKey == '?':
+				showPlaybackHelp(screen)
+				show(dataFetcher, false)
+			case pressedKey == 'p':
+				padMaxHeightParam = !padMaxHeightParam
+				show(dataFetcher, false)
+			case pressedKey == '+':
+				increaseMaxHeight()
+				show(dataFetcher, false)
+			case pressedKey == '-':
+				decreaseMaxHeight()
+				show(dataFetcher, false)
+			case pressedKey == '0':
+				resetMaxHeight()
+				show(dataFetcher, false)
+			case ((pressedKey >= '1' && pressedKey <= '9' && pressedKey <= lastPanelCode) || ev.Key() == tcell.KeyESC) ||
+				(pressedKey >= 'a' && pressedKey <= 'z' && pressedKey <= lastPanelCode):
+				updateExpanded(pressedKey, screen, dataFetcher, parsedLayout)
+			}
+		}
+		if err != nil {
+			close(exit)
+			return err
+		}
+		show(f, true)
+	}
+}
+
+// readPlaybackInput reads a line of input at the bottom of the screen, returning false if ESC is pressed.
+func readPlaybackInput(screen tcell.Screen, prompt string) (string, bool) {
+	var input []rune
+
+	inHelp = true
+	defer func() { inHelp = false }()
+
+	for {
+		updateScreenSize(screen)
+		y := currentScreenHeight - 1
+		line := fmt.Sprintf("%s%s", prompt, string(input))
+		drawText(screen, 0, y, currentScreenWidth, y, textStyle.Reverse(true), fmt.Sprintf("%-*s", currentScreenWidth, line))
+		screen.Show()
+
+		if ev, ok := screen.PollEvent().(*tcell.EventKey); ok {
+			switch ev.Key() {
+			case tcell.KeyESC:
+				return "", false
+			case tcell.KeyEnter:
+				return string(input), true
+			case tcell.KeyBackspace, tcell.KeyBackspace2:
+				if len(input) > 0 {
+					input = input[:len(input)-1]
+				}
+			case tcell.KeyRune:
+				input = append(input, ev.Rune())
+			}
+		}
+	}
+}
+
+// showPlaybackHelp shows the help for playback mode.
+func showPlaybackHelp(screen tcell.Screen) {
+	help := []string{
+		"",
+		"  Monitor Cluster Playback Help ",
+		"",
+		"  - SPACE to pause or resume playback",
+		"  - LEFT / RIGHT to step back or forward",
+		"  - HOME / END to go to the first or last sample",
+		"  - '/' to jump to a time",
+		"  - 'p' to toggle panel row padding",
+		"  - '+' / '-' / '0' to change max height of panels",
+		"  - Key in [] to expand that panel",
+		"  - ESC / CTRL-C to exit playback",
+		"  ",
+		"  Press any key to exit help.",
+	}
+
+	inHelp = true
+	defer func() { inHelp = false }()
+	lenHelp := len(help)
+
+	updateScreenSize(screen)
+
+	x := currentScreenWidth/2 - 25
+	y := currentScreenHeight/2 - lenHelp
+
+	drawBox(screen, x, y, x+53, y+lenHelp+2, boxStyle, "Help")
+
+	for line := 1; line <= lenHelp; line++ {
+		drawText(screen, x+1, y+line, x+currentScreenWidth-1, y+currentScreenHeight-1, textStyle, help[line-1])
+	}
+	screen.Show()
+
+	// ignore the playback timer events until a key is pressed
+	for {
+		if _, ok := screen.PollEvent().(*tcell.EventKey); ok {
+			return
+		}
+	}
+}
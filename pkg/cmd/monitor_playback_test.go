@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPlaybackSamples(t *testing.T) {
+	var (
+		g     = gomega.NewGomegaWithT(t)
+		dir   = t.TempDir()
+		start = time.Date(2026, 10, 17, 23, 58, 0, 0, time.Local)
+	)
+
+	// create samples every minute, crossing midnight, in reverse order
+	for i := 3; i >= 0; i-- {
+		recorded := start.Add(time.Duration(i) * time.Minute)
+		sampleDir := filepath.Join(dir, samplePrefix+recorded.Format(bundleTimestampFormat))
+		g.Expect(os.Mkdir(sampleDir, 0700)).To(gomega.Succeed())
+		manifest := fmt.Sprintf(`{"url":"http://localhost:30000/management/coherence/cluster","recorded":"%s","responses":{}}`,
+			recorded.Format(time.RFC3339))
+		g.Expect(os.WriteFile(filepath.Join(sampleDir, fetcher.ManifestFileName), []byte(manifest), 0600)).To(gomega.Succeed())
+	}
+
+	samples, err := loadPlaybackSamples(dir)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(samples)).To(gomega.Equal(4))
+	g.Expect(samples[0].time.Equal(start)).To(gomega.BeTrue())
+
+	index, err := findSampleForTime(samples, "23:59")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(index).To(gomega.Equal(1))
+
+	// a time before the first sample is assumed to be the next day
+	index, err = findSampleForTime(samples, "00:00:30")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(index).To(gomega.Equal(3))
+
+	index, err = findSampleForTime(samples, "2026-10-17 10:00:00")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(index).To(gomega.Equal(0))
+
+	_, err = findSampleForTime(samples, "invalid")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	// a single recording is a single sample
+	samples, err = loadPlaybackSamples(samples[2].path)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(samples)).To(gomega.Equal(1))
+}
@@ -0,0 +1,434 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	clustersOption      = "clusters"
+	clustersDescription = "comma separated list of cluster connections, or 'all', to query concurrently"
+)
+
+var (
+	// clusterConnections is the list of connections to query concurrently
+	clusterConnections string
+
+	// overviewFetchers caches the fetchers used by the clusters-overview panel
+	overviewFetchers      []clusterFetcher
+	overviewFetchersMutex sync.Mutex
+
+	errNoClusterConnections = errors.New("there are no cluster connections defined")
+)
+
+// clusterFetcher contains the fetcher, or the error creating it, for a cluster connection.
+type clusterFetcher struct {
+	connection  string
+	dataFetcher fetcher.Fetcher
+	err         error
+}
+
+// multiClusterResult contains the information retrieved from a single cluster connection.
+type multiClusterResult[T any] struct {
+	Cluster string `json:"cluster"`
+	Items   T      `json:"items"`
+	Error   string `json:"error,omitempty"`
+}
+
+// clusterMembers contains the members for a cluster and which are storage-enabled.
+type clusterMembers struct {
+	Members    []config.Member `json:"members"`
+	storageMap map[int]bool
+}
+
+// clusterOverview contains the summarised state of a cluster.
+type clusterOverview struct {
+	ClusterName  string `json:"clusterName"`
+	Version      string `json:"version"`
+	MemberCount  int32  `json:"memberCount"`
+	StorageCount int32  `json:"storageCount"`
+	StatusHA     string `json:"statusHA"`
+	HealthTotal  int32  `json:"healthTotal"`
+	HealthSafe   int32  `json:"healthSafe"`
+}
+
+// getMultiClusterConnections returns the connections specified using --clusters.
+func getMultiClusterConnections(value string) ([]string, error) {
+	var connections = make([]string, 0)
+
+	if value == all {
+		for _, c := range Config.Clusters {
+			connections = append(connections, c.Name)
+		}
+		if len(connections) == 0 {
+			return nil, errNoClusterConnections
+		}
+		return connections, nil
+	}
+
+	for _, c := range strings.Split(value, ",") {
+		c = strings.TrimSpace(c)
+		if c == "" || utils.SliceContains(connections, c) {
+			continue
+		}
+		if found, _ := GetClusterConnection(c); !found {
+			return nil, errors.New(UnableToFindClusterMsg + c)
+		}
+		connections = append(connections, c)
+	}
+
+	if len(connections) == 0 {
+		return nil, fmt.Errorf("you must provide at least one cluster connection for --%s", clustersOption)
+	}
+
+	return connections, nil
+}
+
+// getClusterFetchers returns a fetcher for each of the connections. This is done serially as
+// creating a fetcher may update global state, and the credentials for each fetcher are resolved,
+// which may prompt for a username and password, so the fetchers can be used concurrently.
+func getClusterFetchers(connections []string) []clusterFetcher {
+	var fetchers = make([]clusterFetcher, len(connections))
+
+	for i, c := range connections {
+		dataFetcher, err := GetDataFetcher(c)
+		if err == nil {
+			dataFetcher, err = fetcher.ResolveAuth(dataFetcher)
+		}
+		fetchers[i] = clusterFetcher{connection: c, dataFetcher: dataFetcher, err: err}
+	}
+
+	return fetchers
+}
+
+// retrieveFromClusters concurrently retrieves information from each of the clusters, returning
+// the results in the same order as the fetchers.
+func retrieveFromClusters[T any](fetchers []clusterFetcher, retrieve func(fetcher.Fetcher) (T, error)) []multiClusterResult[T] {
+	var (
+		results = make([]multiClusterResult[T], len(fetchers))
+		wg      sync.WaitGroup
+	)
+
+	for i, f := range fetchers {
+		results[i].Cluster = f.connection
+		if f.err != nil {
+			results[i].Error = f.err.Error()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, dataFetcher fetcher.Fetcher) {
+			defer wg.Done()
+			items, err := retrieve(dataFetcher)
+			if err != nil {
+				results[i].Error = err.Error()
+				return
+			}
+			results[i].Items = items
+		}(i, f.dataFetcher)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// runMultiClusterCommand retrieves and displays information from the clusters specified
+// using --clusters, honouring the watch and output format options. If withRules is true then
+// any rules are evaluated against each cluster and violations cause a non-zero exit code.
+func runMultiClusterCommand[T any](cmd *cobra.Command, retrieve func(fetcher.Fetcher) (T, error),
+	format func([]multiClusterResult[T]) string, withRules bool) error {
+	connections, err := getMultiClusterConnections(clusterConnections)
+	if err != nil {
+		return err
+	}
+
+	fetchers := getClusterFetchers(connections)
+
+	for {
+		var violations []RuleViolation
+
+		results := retrieveFromClusters(fetchers, retrieve)
+
+		if withRules {
+			if violations, err = checkMultiClusterRules(fetchers); err != nil {
+				return err
+			}
+		}
+
+		if isJSONPathOrJSON() {
+			data, err := json.Marshal(results)
+			if err != nil {
+				return err
+			}
+			if err = processJSONOutput(cmd, data); err != nil {
+				return err
+			}
+		} else {
+			printWatchHeader(cmd)
+
+			cmd.Println(FormatCurrentClusters(connections))
+			cmd.Println(format(results))
+
+			for _, r := range results {
+				if r.Error != "" {
+					cmd.Printf("Unable to retrieve information for cluster connection %s: %s\n", r.Cluster, r.Error)
+				}
+			}
+
+			if len(violations) > 0 {
+				cmd.Println(FormatRuleViolations(violations))
+			}
+		}
+
+		// check to see if we should exit if we are not watching
+		if !isWatchEnabled() {
+			if err = getMultiClusterError(results); err != nil {
+				return err
+			}
+			return getRuleViolationsError(violations)
+		}
+		// we are watching so sleep and then repeat until CTRL-C
+		time.Sleep(time.Duration(watchDelay) * time.Second)
+	}
+}
+
+// getMultiClusterError returns an error if any of the clusters could not be queried.
+func getMultiClusterError[T any](results []multiClusterResult[T]) error {
+	var count = 0
+	for _, r := range results {
+		if r.Error != "" {
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	return fmt.Errorf("unable to retrieve information for %d of %d cluster connections", count, len(results))
+}
+
+// retrieveClusterMembers retrieves the members for a cluster, applying any role filter.
+func retrieveClusterMembers(dataFetcher fetcher.Fetcher) (clusterMembers, error) {
+	var (
+		members = config.Members{}
+		storage = config.StorageDetails{}
+		result  = clusterMembers{Members: make([]config.Member, 0)}
+	)
+
+	membersResult, err := dataFetcher.GetMemberDetailsJSON(false)
+	if err != nil {
+		return result, err
+	}
+
+	storageResult, err := dataFetcher.GetStorageDetailsJSON()
+	if err != nil {
+		return result, err
+	}
+
+	if err = json.Unmarshal(membersResult, &members); err != nil {
+		return result, utils.GetError(unableToDecode, err)
+	}
+
+	if err = json.Unmarshal(storageResult, &storage); err != nil {
+		return result, utils.GetError("unable to decode storage details", err)
+	}
+
+	for _, value := range members.Members {
+		if roleName == all || value.RoleName == roleName {
+			result.Members = append(result.Members, value)
+		}
+	}
+
+	sort.Slice(result.Members, func(p, q int) bool {
+		nodeID1, _ := strconv.Atoi(result.Members[p].NodeID)
+		nodeID2, _ := strconv.Atoi(result.Members[q].NodeID)
+		return nodeID1 < nodeID2
+	})
+
+	result.storageMap = utils.GetStorageMap(storage)
+
+	return result, nil
+}
+
+// retrieveClusterServices retrieves the services for a cluster, applying any service type filter.
+func retrieveClusterServices(dataFetcher fetcher.Fetcher) ([]config.ServiceSummary, error) {
+	var servicesSummary = config.ServicesSummaries{}
+
+	servicesResult, err := dataFetcher.GetServiceDetailsJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(servicesResult, &servicesSummary); err != nil {
+		return nil, utils.GetError("unable to unmarshall service result", err)
+	}
+
+	services := DeduplicateServices(servicesSummary, serviceType)
+	sort.Slice(services, func(p, q int) bool {
+		return services[p].ServiceName < services[q].ServiceName
+	})
+
+	return services, nil
+}
+
+// retrieveClusterCaches retrieves the caches for a cluster, applying any service filter.
+func retrieveClusterCaches(dataFetcher fetcher.Fetcher) ([]config.CacheSummaryDetail, error) {
+	var servicesSummary = config.ServicesSummaries{}
+
+	servicesResult, err := dataFetcher.GetServiceDetailsJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(servicesResult, &servicesSummary); err != nil {
+		return nil, err
+	}
+
+	serviceList := GetListOfCacheServices(servicesSummary)
+
+	if serviceName != "" {
+		if !utils.SliceContains(serviceList, serviceName) {
+			return nil, fmt.Errorf("service '%s' was not found", serviceName)
+		}
+		serviceList = []string{serviceName}
+	}
+
+	caches, err := getCachesSummary(serviceList, dataFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(caches, func(p, q int) bool {
+		if caches[p].ServiceName != caches[q].ServiceName {
+			return caches[p].ServiceName < caches[q].ServiceName
+		}
+		return caches[p].CacheName < caches[q].CacheName
+	})
+
+	return caches, nil
+}
+
+// retrieveClusterHealth retrieves the health for a cluster summarised across members.
+func retrieveClusterHealth(dataFetcher fetcher.Fetcher) ([]config.HealthSummaryShort, error) {
+	var healthSummaries = config.HealthSummaries{}
+
+	healthData, err := dataFetcher.GetMembersHealth()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(healthData) > 0 {
+		if err = json.Unmarshal(healthData, &healthSummaries); err != nil {
+			return nil, err
+		}
+	}
+
+	health := summariseHealth(filterHealth(healthSummaries))
+	sort.Slice(health, func(p, q int) bool {
+		return health[p].Name < health[q].Name
+	})
+
+	return health, nil
+}
+
+// retrieveClusterOverview retrieves the member count, worst StatusHA and health for a cluster.
+func retrieveClusterOverview(dataFetcher fetcher.Fetcher) (clusterOverview, error) {
+	var (
+		cluster         = config.Cluster{}
+		overview        = clusterOverview{StatusHA: na}
+		healthSummaries = config.HealthSummaries{}
+		servicesSummary = config.ServicesSummaries{}
+		statusHAIndex   = len(allStatusHA)
+	)
+
+	clusterResult, err := dataFetcher.GetClusterDetailsJSON()
+	if err != nil {
+		return overview, err
+	}
+
+	if err = json.Unmarshal(clusterResult, &cluster); err != nil {
+		return overview, utils.GetError("unable to decode cluster details", err)
+	}
+
+	overview.ClusterName = cluster.ClusterName
+	overview.Version = cluster.Version
+	overview.MemberCount = int32(cluster.ClusterSize)
+
+	servicesResult, err := dataFetcher.GetServiceDetailsJSON()
+	if err != nil {
+		return overview, err
+	}
+
+	if err = json.Unmarshal(servicesResult, &servicesSummary); err != nil {
+		return overview, utils.GetError("unable to unmarshall service result", err)
+	}
+
+	for _, s := range DeduplicateServices(servicesSummary, all) {
+		if s.StorageEnabledCount > overview.StorageCount {
+			overview.StorageCount = s.StorageEnabledCount
+		}
+		if index := utils.GetSliceIndex(allStatusHA, s.StatusHA); index >= 0 && index < statusHAIndex {
+			statusHAIndex = index
+			overview.StatusHA = s.StatusHA
+		}
+	}
+
+	// health is not available in all versions so ignore any errors
+	healthData, err := dataFetcher.GetMembersHealth()
+	if err == nil && len(healthData) > 0 && json.Unmarshal(healthData, &healthSummaries) == nil {
+		for _, h := range summariseHealth(healthSummaries.Summaries) {
+			overview.HealthTotal += h.TotalCount
+			overview.HealthSafe += h.SafeCount
+		}
+	}
+
+	return overview, nil
+}
+
+// getOverviewFetchers returns the cached fetchers for the clusters-overview panel.
+func getOverviewFetchers() ([]clusterFetcher, error) {
+	overviewFetchersMutex.Lock()
+	defer overviewFetchersMutex.Unlock()
+
+	if overviewFetchers == nil {
+		value := clusterConnections
+		if value == "" {
+			value = all
+		}
+		connections, err := getMultiClusterConnections(value)
+		if err != nil {
+			return nil, err
+		}
+		overviewFetchers = getClusterFetchers(connections)
+	}
+
+	return overviewFetchers, nil
+}
+
+var clustersOverviewContent = func(_ fetcher.Fetcher, _ clusterSummaryInfo) ([]string, error) {
+	if playbackPath != "" || recordSamplesDir != "" {
+		return []string{"Clusters overview is not available when recording or playing back"}, nil
+	}
+
+	fetchers, err := getOverviewFetchers()
+	if err != nil {
+		return emptyStringArray, err
+	}
+
+	return strings.Split(FormatClustersOverview(retrieveFromClusters(fetchers, retrieveClusterOverview)), "\n"), nil
+}
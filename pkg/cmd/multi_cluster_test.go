@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"strings"
+	"testing"
+)
+
+func TestRetrieveFromClusters(t *testing.T) {
+	var (
+		g        = gomega.NewGomegaWithT(t)
+		fetchers = []clusterFetcher{{connection: "c1"}, {connection: "c2", err: errors.New("no fetcher")}, {connection: "c3"}}
+	)
+
+	results := retrieveFromClusters(fetchers, func(_ fetcher.Fetcher) (clusterOverview, error) {
+		return clusterOverview{ClusterName: "cluster", MemberCount: 3, StatusHA: "NODE-SAFE", HealthTotal: 3, HealthSafe: 2}, nil
+	})
+
+	g.Expect(len(results)).To(gomega.Equal(3))
+	g.Expect(results[0].Cluster).To(gomega.Equal("c1"))
+	g.Expect(results[1].Error).To(gomega.Equal("no fetcher"))
+	g.Expect(results[2].Items.MemberCount).To(gomega.Equal(int32(3)))
+
+	err := getMultiClusterError(results)
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(err.Error()).To(gomega.ContainSubstring("1 of 3"))
+
+	output := FormatClustersOverview(results)
+	g.Expect(strings.Count(output, "NODE-SAFE")).To(gomega.Equal(2))
+	g.Expect(output).To(gomega.ContainSubstring("2/3"))
+	g.Expect(output).To(gomega.ContainSubstring("ERROR: no fetcher"))
+}
+
+func TestGetMultiClusterConnections(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	Config.Clusters = []ClusterConnection{{Name: "c1"}, {Name: "c2"}}
+	defer func() { Config.Clusters = nil }()
+
+	connections, err := getMultiClusterConnections(all)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(connections).To(gomega.Equal([]string{"c1", "c2"}))
+
+	connections, err = getMultiClusterConnections("c2, c1,c2")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(connections).To(gomega.Equal([]string{"c2", "c1"}))
+
+	_, err = getMultiClusterConnections("c1,c3")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
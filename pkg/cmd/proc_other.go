@@ -1,7 +1,7 @@
 //go:build darwin || linux
 
 /*
- * Copyright (c) 2022, 2023 Oracle and/or its affiliates.
+ * Copyright (c) 2022, 2026 Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * https://oss.oracle.com/licenses/upl.
  */
@@ -9,7 +9,12 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+	"os"
 	"os/exec"
+	"strconv"
+	"strings"
 	"syscall"
 )
 
@@ -19,3 +24,42 @@ func setForkProcess(process *exec.Cmd) {
 		Setsid: true,
 	}
 }
+
+// isProcessRunning returns true if a process with the pid is running.
+func isProcessRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
+
+// terminateProcess asks a process to exit by sending SIGTERM, which allows the JVM to run its shutdown hooks.
+func terminateProcess(pid int) error {
+	return syscall.Kill(pid, syscall.SIGTERM)
+}
+
+// getProcessRSS returns the resident set size in bytes for a process or -1 if it cannot be determined.
+func getProcessRSS(pid int) int64 {
+	output, err := exec.Command("ps", "-o", "rss=", "-p", fmt.Sprintf("%d", pid)).Output() // #nosec G204
+	if err != nil {
+		return -1
+	}
+	rss, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+	if err != nil {
+		return -1
+	}
+	return rss * 1024
+}
+
+// getProcessStartTime returns the time a process was started, as reported by ps, or an empty
+// string if it cannot be determined. This is used to detect a pid being reused by another process.
+func getProcessStartTime(pid int) string {
+	command := exec.Command("ps", "-o", "lstart=", "-p", fmt.Sprintf("%d", pid)) // #nosec G204
+	command.Env = append(os.Environ(), "LC_ALL=C")
+	output, err := command.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
@@ -1,7 +1,7 @@
 //go:build windows
 
 /*
- * Copyright (c) 2022, 2023 Oracle and/or its affiliates.
+ * Copyright (c) 2022, 2026 Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * https://oss.oracle.com/licenses/upl.
  */
@@ -9,13 +9,67 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
 	"os/exec"
 	"syscall"
 )
 
+// stillActive is the exit code returned for a process that has not exited.
+const stillActive = 259
+
 // setForkProcess set the process to be forked for windows.
 func setForkProcess(cmd *exec.Cmd) {
 	cmd.SysProcAttr = &syscall.SysProcAttr{
 		CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP,
 	}
 }
+
+// isProcessRunning returns true if a process with the pid is running.
+func isProcessRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	handle, err := syscall.OpenProcess(syscall.PROCESS_QUERY_INFORMATION, false, uint32(pid)) // #nosec G115
+	if err != nil {
+		return false
+	}
+	defer syscall.CloseHandle(handle)
+
+	var exitCode uint32
+	if err = syscall.GetExitCodeProcess(handle, &exitCode); err != nil {
+		return false
+	}
+	return exitCode == stillActive
+}
+
+// terminateProcess terminates a process. Windows has no equivalent of SIGTERM
+// for a process without a console, so the process is terminated immediately.
+func terminateProcess(pid int) error {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	return proc.Kill()
+}
+
+// getProcessRSS returns -1 as the resident set size is not available on windows.
+func getProcessRSS(_ int) int64 {
+	return -1
+}
+
+// getProcessStartTime returns the creation time of a process or an empty string if it cannot
+// be determined. This is used to detect a pid being reused by another process.
+func getProcessStartTime(pid int) string {
+	handle, err := syscall.OpenProcess(syscall.PROCESS_QUERY_INFORMATION, false, uint32(pid)) // #nosec G115
+	if err != nil {
+		return ""
+	}
+	defer syscall.CloseHandle(handle)
+
+	var creation, exit, kernel, user syscall.Filetime
+	if err = syscall.GetProcessTimes(handle, &creation, &exit, &kernel, &user); err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d", creation.Nanoseconds())
+}
@@ -0,0 +1,373 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	processesFile        = "processes.json"
+	processesLockTimeout = 10
+	processesLockStale   = 30
+)
+
+var maxRestartsParam int32
+
+// memberProcess describes a cluster member process started by cohctl.
+type memberProcess struct {
+	Cluster   string    `json:"cluster"`
+	Member    string    `json:"member"`
+	PID       int       `json:"pid"`
+	Started   time.Time `json:"started"`
+	LogFile   string    `json:"logFile"`
+	Arguments []string  `json:"arguments"`
+	Restarts  int32     `json:"restarts"`
+
+	// ProcessStart is the start time of the process reported by the operating system, which
+	// is checked so that a process which has reused the pid is not treated as the member
+	ProcessStart string `json:"processStart,omitempty"`
+}
+
+// memberProcessDetails contains a member process along with its current status.
+type memberProcessDetails struct {
+	memberProcess
+	Running      bool  `json:"running"`
+	UptimeMillis int64 `json:"uptimeMillis"`
+	RSS          int64 `json:"rss"`
+}
+
+// getProcessesCmd represents the get processes command.
+var getProcessesCmd = &cobra.Command{
+	Use:   "processes [cluster-name]",
+	Short: "display the member processes started for manually created clusters",
+	Long: `The 'get processes' command displays the member processes started by cohctl for manually
+created clusters, including the PID, member name, uptime, resident memory and log file. This is
+available even if management over REST is not available. Specify a cluster name to only display
+the processes for that cluster.`,
+	ValidArgsFunction: completionAllManualClusters,
+	Args:              cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var clusterName string
+		if len(args) == 1 {
+			clusterName = args[0]
+		}
+
+		processes, err := loadMemberProcesses()
+		if err != nil {
+			return err
+		}
+
+		details := make([]memberProcessDetails, 0)
+		for _, p := range processes {
+			if clusterName != "" && p.Cluster != clusterName {
+				continue
+			}
+			detail := memberProcessDetails{memberProcess: p, Running: isMemberProcessRunning(p), RSS: -1}
+			if detail.Running {
+				detail.RSS = getProcessRSS(p.PID)
+				detail.UptimeMillis = time.Since(p.Started).Milliseconds()
+			}
+			details = append(details, detail)
+		}
+
+		if isJSONPathOrJSON() {
+			jsonData, err := json.Marshal(details)
+			if err != nil {
+				return err
+			}
+			return processJSONOutput(cmd, jsonData)
+		}
+
+		cmd.Println(FormatMemberProcesses(details))
+		return nil
+	},
+}
+
+// superviseCmd represents the supervise command.
+var superviseCmd = &cobra.Command{
+	Use:   "supervise",
+	Short: "supervise a resource",
+	Long:  `The 'supervise' command supervises various resources.`,
+}
+
+// superviseClusterCmd represents the supervise cluster command.
+var superviseClusterCmd = &cobra.Command{
+	Use:   "cluster cluster-name",
+	Short: "restart members of a local Coherence cluster that have crashed",
+	Long: `The 'supervise cluster' command checks the member processes of a cluster that was manually
+created and restarts any members that have crashed, using the same arguments they were started with.
+The previous log file for a crashed member is saved with a '.crashed' suffix. Members that were
+stopped using 'stop cluster' are not restarted. The check is made every delay seconds, specified
+by '-d', until CTRL-C is pressed.`,
+	ValidArgsFunction: completionAllManualClusters,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, youMustProviderConnectionMessage)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			connectionName = args[0]
+			warned         = make(map[string]bool)
+			err            error
+		)
+
+		found, connection := GetClusterConnection(connectionName)
+		if !found {
+			return errors.New(UnableToFindClusterMsg + connectionName)
+		}
+
+		if err = checkOperation(connection, superviseClusterCommand); err != nil {
+			return err
+		}
+
+		if err = checkRuntimeRequirements(); err != nil {
+			return err
+		}
+
+		if len(getClusterProcesses(connectionName)) == 0 {
+			return fmt.Errorf("no member processes are tracked for cluster %s, please start the cluster", connectionName)
+		}
+
+		cmd.Printf("Supervising cluster %s, checking every %d seconds. Press CTRL-C to exit\n", connectionName, watchDelay)
+
+		for {
+			for _, p := range getClusterProcesses(connectionName) {
+				if isMemberProcessRunning(p) {
+					continue
+				}
+
+				if p.Restarts >= maxRestartsParam {
+					if !warned[p.Member] {
+						cmd.Printf("%s member %s has been restarted %d times, not restarting\n", time.Now().Format(time.DateTime), p.Member, p.Restarts)
+						warned[p.Member] = true
+					}
+					continue
+				}
+
+				cmd.Printf("%s member %s with process %d has crashed, restarting\n", time.Now().Format(time.DateTime), p.Member, p.PID)
+				if err = restartMemberProcess(p); err != nil {
+					cmd.Printf("%s unable to restart member %s: %v\n", time.Now().Format(time.DateTime), p.Member, err)
+				}
+			}
+
+			time.Sleep(time.Duration(watchDelay) * time.Second)
+		}
+	},
+}
+
+// restartMemberProcess restarts a crashed member process using the same arguments and log file.
+func restartMemberProcess(p memberProcess) error {
+	// save the log file from the crashed member as it is overwritten on start
+	if _, err := os.Stat(p.LogFile); err == nil {
+		if err = os.Rename(p.LogFile, p.LogFile+".crashed"); err != nil {
+			return utils.GetError("unable to save log file "+p.LogFile, err)
+		}
+	}
+
+	pid, err := runCommandAsync(javaExec, p.LogFile, p.Arguments)
+	if err != nil {
+		return err
+	}
+
+	return trackMemberProcess(p.Cluster, p.Member, pid, p.LogFile, p.Arguments, p.Restarts+1)
+}
+
+// getProcessesFile returns the file the member processes are saved to.
+func getProcessesFile() string {
+	return filepath.Join(cfgDirectory, processesFile)
+}
+
+// loadMemberProcesses loads the member processes that have been started.
+func loadMemberProcesses() ([]memberProcess, error) {
+	var (
+		processes = make([]memberProcess, 0)
+		fileName  = getProcessesFile()
+	)
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return processes, nil
+		}
+		return processes, utils.GetError("unable to read "+fileName, err)
+	}
+
+	if err = json.Unmarshal(data, &processes); err != nil {
+		return processes, utils.GetError("unable to unmarshall "+fileName, err)
+	}
+
+	return processes, nil
+}
+
+// saveMemberProcesses saves the member processes. The file is written to a temporary file
+// and renamed so that a reader never sees a partially written file.
+func saveMemberProcesses(processes []memberProcess) error {
+	var (
+		fileName = getProcessesFile()
+		tmpFile  = fileName + ".tmp"
+	)
+
+	data, err := json.MarshalIndent(processes, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(tmpFile, data, 0600); err != nil {
+		return utils.GetError("unable to write "+tmpFile, err)
+	}
+
+	if err = os.Rename(tmpFile, fileName); err != nil {
+		return utils.GetError("unable to write "+fileName, err)
+	}
+
+	return nil
+}
+
+// lockMemberProcesses acquires an exclusive lock on the processes file so that other invocations
+// and supervisors do not overwrite each other's changes, returning a function to release the lock.
+// A lock older than processesLockStale seconds is assumed to have been left by a process that
+// exited and is removed.
+func lockMemberProcesses() (func(), error) {
+	var (
+		lockFile  = getProcessesFile() + ".lock"
+		startTime = time.Now()
+	)
+
+	for {
+		file, err := os.OpenFile(lockFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_ = file.Close()
+			return func() { _ = os.Remove(lockFile) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, utils.GetError("unable to create "+lockFile, err)
+		}
+
+		if info, err1 := os.Stat(lockFile); err1 == nil && time.Since(info.ModTime()) > time.Duration(processesLockStale)*time.Second {
+			_ = os.Remove(lockFile)
+			continue
+		}
+
+		if time.Since(startTime) > time.Duration(processesLockTimeout)*time.Second {
+			return nil, fmt.Errorf("unable to lock %s within %d seconds", getProcessesFile(), processesLockTimeout)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// updateMemberProcesses loads the member processes, applies the update and saves the
+// result while holding the lock on the processes file.
+func updateMemberProcesses(update func([]memberProcess) []memberProcess) error {
+	unlock, err := lockMemberProcesses()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	processes, err := loadMemberProcesses()
+	if err != nil {
+		return err
+	}
+
+	return saveMemberProcesses(update(processes))
+}
+
+// trackMemberProcess saves the details of a member process that has been started,
+// replacing any previous process for the member.
+func trackMemberProcess(cluster, member, pid, logFile string, arguments []string, restarts int32) error {
+	processID, err := strconv.Atoi(pid)
+	if err != nil {
+		return fmt.Errorf("invalid process id %s for member %s", pid, member)
+	}
+
+	newProcess := memberProcess{Cluster: cluster, Member: member, PID: processID, Started: time.Now(),
+		LogFile: logFile, Arguments: arguments, Restarts: restarts, ProcessStart: getProcessStartTime(processID)}
+
+	return updateMemberProcesses(func(processes []memberProcess) []memberProcess {
+		newProcesses := make([]memberProcess, 0, len(processes)+1)
+		for _, p := range processes {
+			if p.Cluster != cluster || p.Member != member {
+				newProcesses = append(newProcesses, p)
+			}
+		}
+		return append(newProcesses, newProcess)
+	})
+}
+
+// untrackMemberProcesses removes the member processes for a cluster. If pids are specified then
+// only the processes with those pids are removed, otherwise all processes for the cluster are removed.
+func untrackMemberProcesses(cluster string, pids ...int) error {
+	return updateMemberProcesses(func(processes []memberProcess) []memberProcess {
+		newProcesses := make([]memberProcess, 0, len(processes))
+		for _, p := range processes {
+			if p.Cluster == cluster && (len(pids) == 0 || slices.Contains(pids, p.PID)) {
+				continue
+			}
+			newProcesses = append(newProcesses, p)
+		}
+		return newProcesses
+	})
+}
+
+// isMemberProcessRunning returns true if the process for a member is running. The start time of
+// the process is also checked, as after a reboot the pid may have been reused by another process.
+// If the start time cannot be determined then pid reuse cannot be detected, and only the pid is checked.
+func isMemberProcessRunning(p memberProcess) bool {
+	if !isProcessRunning(p.PID) {
+		return false
+	}
+	if p.ProcessStart == "" {
+		return true
+	}
+	startTime := getProcessStartTime(p.PID)
+	return startTime == "" || startTime == p.ProcessStart
+}
+
+// getClusterProcesses returns the member processes for a cluster, ignoring any errors.
+func getClusterProcesses(cluster string) []memberProcess {
+	var clusterProcesses = make([]memberProcess, 0)
+
+	processes, err := loadMemberProcesses()
+	if err != nil {
+		return clusterProcesses
+	}
+
+	for _, p := range processes {
+		if p.Cluster == cluster {
+			clusterProcesses = append(clusterProcesses, p)
+		}
+	}
+
+	return clusterProcesses
+}
+
+// getRunningClusterProcessIDs returns the pids of the running member processes for a cluster.
+func getRunningClusterProcessIDs(cluster string) []int {
+	var pids = make([]int, 0)
+	for _, p := range getClusterProcesses(cluster) {
+		if isMemberProcessRunning(p) {
+			pids = append(pids, p.PID)
+		}
+	}
+	return pids
+}
+
+func init() {
+	superviseClusterCmd.Flags().Int32VarP(&maxRestartsParam, "max-restarts", "R", 3, "maximum number of times to restart a crashed member")
+}
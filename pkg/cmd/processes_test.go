@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"github.com/onsi/gomega"
+	"os"
+	"testing"
+)
+
+func TestTrackMemberProcesses(t *testing.T) {
+	var (
+		g           = gomega.NewGomegaWithT(t)
+		originalDir = cfgDirectory
+		pid         = os.Getpid()
+	)
+
+	cfgDirectory = t.TempDir()
+	defer func() { cfgDirectory = originalDir }()
+
+	g.Expect(isProcessRunning(pid)).To(gomega.BeTrue())
+	g.Expect(isProcessRunning(-1)).To(gomega.BeFalse())
+
+	g.Expect(trackMemberProcess("local", "storage-0", fmt.Sprintf("%d", pid), "storage-0.log", []string{"-cp"}, 0)).To(gomega.Succeed())
+	g.Expect(trackMemberProcess("local", "storage-1", "999999999", "storage-1.log", []string{"-cp"}, 0)).To(gomega.Succeed())
+	g.Expect(trackMemberProcess("other", "storage-0", "999999998", "storage-0.log", []string{"-cp"}, 0)).To(gomega.Succeed())
+	g.Expect(trackMemberProcess("local", "storage-0", "abc", "storage-0.log", nil, 0)).To(gomega.HaveOccurred())
+
+	// restarting a member replaces the existing entry
+	g.Expect(trackMemberProcess("local", "storage-1", "999999997", "storage-1.log", []string{"-cp"}, 1)).To(gomega.Succeed())
+
+	processes := getClusterProcesses("local")
+	g.Expect(len(processes)).To(gomega.Equal(2))
+	g.Expect(processes[1].PID).To(gomega.Equal(999999997))
+	g.Expect(processes[1].Restarts).To(gomega.Equal(int32(1)))
+
+	g.Expect(getRunningClusterProcessIDs("local")).To(gomega.Equal([]int{pid}))
+
+	// a process which has reused the pid is not treated as the member
+	g.Expect(isMemberProcessRunning(processes[0])).To(gomega.BeTrue())
+	processes[0].ProcessStart = "Thu Jan  1 00:00:00 1970"
+	g.Expect(isMemberProcessRunning(processes[0])).To(gomega.BeFalse())
+
+	// if the start time is unavailable then only the pid is checked
+	processes[0].ProcessStart = ""
+	g.Expect(isMemberProcessRunning(processes[0])).To(gomega.BeTrue())
+
+	// the lock is released after each update
+	g.Expect(getProcessesFile() + ".lock").To(gomega.Not(gomega.BeAnExistingFile()))
+
+	g.Expect(untrackMemberProcesses("local", pid)).To(gomega.Succeed())
+	g.Expect(len(getClusterProcesses("local"))).To(gomega.Equal(1))
+
+	g.Expect(untrackMemberProcesses("local")).To(gomega.Succeed())
+	g.Expect(len(getClusterProcesses("local"))).To(gomega.Equal(0))
+	g.Expect(len(getClusterProcesses("other"))).To(gomega.Equal(1))
+}
@@ -150,8 +150,13 @@ func init() {
 }
 
 func validateProfileName(profileName string) error {
-	if !isValid(profileName) {
-		return fmt.Errorf("profile name %s must only contain letters, numbers and '", profileName)
+	return validateName("profile", profileName)
+}
+
+// validateName validates the name of an object of the given kind, such as a profile or rule.
+func validateName(kind, name string) error {
+	if !isValid(name) {
+		return fmt.Errorf("%s name %s must only contain letters, numbers and '", kind, name)
 	}
 
 	return nil
@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	recordFormatCSV    = "csv"
+	recordFormatNDJSON = "ndjson"
+	timestampColumn    = "timestamp"
+)
+
+var (
+	// recordFile is the file to append watch samples to
+	recordFile string
+
+	// recordFormat is the format of the recorded samples, csv or ndjson
+	recordFormat string
+)
+
+// recordedField contains the name and value of a field in a recorded sample.
+type recordedField struct {
+	name  string
+	value interface{}
+}
+
+// validateRecordOptions validates the record options.
+func validateRecordOptions() error {
+	if recordFile == "" {
+		return nil
+	}
+	if isJSONPathOrJSON() {
+		return fmt.Errorf("the --record option cannot be used with output format %s", OutputFormat)
+	}
+	if format := getRecordFormat(); format != recordFormatCSV && format != recordFormatNDJSON {
+		return fmt.Errorf("invalid record format %s, must be %s or %s", format, recordFormatCSV, recordFormatNDJSON)
+	}
+	return nil
+}
+
+// getRecordCommands returns the commands that support the --record option.
+func getRecordCommands() []*cobra.Command {
+	return []*cobra.Command{getCachesCmd, getCacheAccessCmd, getCacheStorageCmd, getCachePartitionsCmd,
+		getCacheIndexesCmd, getHealthCmd, getMembersCmd, getServicesCmd, getServiceStorageCmd}
+}
+
+// validateRecordCommand returns an error if the --record option has been specified for a
+// command that does not support it, rather than silently ignoring it.
+func validateRecordCommand(cmd *cobra.Command) error {
+	if recordFile == "" || slices.Contains(getRecordCommands(), cmd) {
+		return nil
+	}
+
+	supported := make([]string, 0)
+	for _, c := range getRecordCommands() {
+		supported = append(supported, "'"+c.CommandPath()+"'")
+	}
+	return fmt.Errorf("the --record option is not supported by '%s', it is only supported by %s",
+		cmd.CommandPath(), strings.Join(supported, ", "))
+}
+
+// getRecordFormat returns the record format, using the file extension if no format is specified.
+func getRecordFormat() string {
+	if recordFormat != "" {
+		return recordFormat
+	}
+	if strings.HasSuffix(strings.ToLower(recordFile), "."+recordFormatCSV) {
+		return recordFormatCSV
+	}
+	return recordFormatNDJSON
+}
+
+// recordWatchSample appends each element of a slice of structs, or a single struct, to the
+// record file as timestamped rows, if the --record option has been specified.
+func recordWatchSample(sample interface{}) error {
+	if recordFile == "" {
+		return nil
+	}
+
+	rows := getRecordedRows(sample, time.Now())
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// determine if the file is new so we can write a CSV header
+	info, err := os.Stat(recordFile)
+	newFile := err != nil || info.Size() == 0
+
+	file, err := os.OpenFile(recordFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return utils.GetError("unable to open record file "+recordFile, err)
+	}
+	defer file.Close()
+
+	var data []byte
+	if getRecordFormat() == recordFormatCSV {
+		data, err = formatRecordedRowsCSV(rows, newFile)
+	} else {
+		data, err = formatRecordedRowsNDJSON(rows)
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err = file.Write(data); err != nil {
+		return utils.GetError("unable to write to record file "+recordFile, err)
+	}
+
+	return nil
+}
+
+// getRecordedRows returns the fields for each row in the sample, prefixed with the timestamp.
+func getRecordedRows(sample interface{}, timestamp time.Time) [][]recordedField {
+	var (
+		rows  = make([][]recordedField, 0)
+		value = reflect.Indirect(reflect.ValueOf(sample))
+	)
+
+	if value.Kind() == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			rows = append(rows, getRecordedFields(reflect.Indirect(value.Index(i)), timestamp))
+		}
+	} else if value.Kind() == reflect.Struct {
+		rows = append(rows, getRecordedFields(value, timestamp))
+	}
+
+	return rows
+}
+
+// getRecordedFields returns the exported fields of a struct using the json name if present.
+func getRecordedFields(value reflect.Value, timestamp time.Time) []recordedField {
+	var fields = []recordedField{{name: timestampColumn, value: timestamp.Format(time.RFC3339)}}
+
+	if value.Kind() != reflect.Struct {
+		return fields
+	}
+
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			if tag = strings.Split(tag, ",")[0]; tag == "-" {
+				continue
+			} else if tag != "" {
+				name = tag
+			}
+		}
+		fields = append(fields, recordedField{name: name, value: value.Field(i).Interface()})
+	}
+
+	return fields
+}
+
+// formatRecordedRowsCSV formats the rows as CSV, including a header if required.
+func formatRecordedRowsCSV(rows [][]recordedField, includeHeader bool) ([]byte, error) {
+	var (
+		buffer bytes.Buffer
+		writer = csv.NewWriter(&buffer)
+	)
+
+	if includeHeader {
+		header := make([]string, 0, len(rows[0]))
+		for _, f := range rows[0] {
+			header = append(header, f.name)
+		}
+		if err := writer.Write(header); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, row := range rows {
+		record := make([]string, 0, len(row))
+		for _, f := range row {
+			record = append(record, formatRecordedValue(f.value))
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
+
+// formatRecordedRowsNDJSON formats the rows as newline delimited JSON, preserving the field order.
+func formatRecordedRowsNDJSON(rows [][]recordedField) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	for _, row := range rows {
+		buffer.WriteString("{")
+		for i, f := range row {
+			name, err := json.Marshal(f.name)
+			if err != nil {
+				return nil, err
+			}
+			value, err := json.Marshal(f.value)
+			if err != nil {
+				return nil, err
+			}
+			if i > 0 {
+				buffer.WriteString(",")
+			}
+			buffer.Write(name)
+			buffer.WriteString(":")
+			buffer.Write(value)
+		}
+		buffer.WriteString("}\n")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// formatRecordedValue formats a value for CSV output, using JSON for complex values.
+func formatRecordedValue(value interface{}) string {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Map, reflect.Ptr:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
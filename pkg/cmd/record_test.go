@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecordWatchSample(t *testing.T) {
+	var (
+		g      = gomega.NewGomegaWithT(t)
+		dir    = t.TempDir()
+		caches = []config.CacheSummaryDetail{
+			{ServiceName: "PartitionedCache", CacheName: "test", CacheSize: 10},
+			{ServiceName: "PartitionedCache", CacheName: "test,2", CacheSize: 20},
+		}
+	)
+
+	defer func() {
+		recordFile = ""
+	}()
+
+	// CSV should only include the header once
+	recordFile = filepath.Join(dir, "caches.csv")
+	g.Expect(recordWatchSample(caches)).To(gomega.Succeed())
+	g.Expect(recordWatchSample(caches[:1])).To(gomega.Succeed())
+
+	data, err := os.ReadFile(recordFile)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	g.Expect(len(lines)).To(gomega.Equal(4))
+	g.Expect(lines[0]).To(gomega.HavePrefix("timestamp,service,name,size,unitsBytes"))
+	g.Expect(lines[2]).To(gomega.ContainSubstring(`,PartitionedCache,"test,2",20,`))
+
+	// NDJSON should preserve the field order
+	recordFile = filepath.Join(dir, "services.ndjson")
+	g.Expect(recordWatchSample(config.ServiceSummary{ServiceName: "Proxy", Snapshots: []string{"s1"}})).To(gomega.Succeed())
+
+	data, err = os.ReadFile(recordFile)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.HavePrefix(`{"timestamp":"`))
+	g.Expect(string(data)).To(gomega.ContainSubstring(`"nodeId":"","name":"Proxy","type":""`))
+	g.Expect(string(data)).To(gomega.ContainSubstring(`"Snapshots":["s1"]`))
+}
+
+func TestValidateRecordCommand(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	defer func() {
+		recordFile = ""
+	}()
+
+	g.Expect(validateRecordCommand(getClustersCmd)).To(gomega.Succeed())
+
+	recordFile = "samples.csv"
+	g.Expect(validateRecordCommand(getServiceStorageCmd)).To(gomega.Succeed())
+	g.Expect(validateRecordCommand(getClustersCmd)).To(gomega.HaveOccurred())
+}
+
+func TestRecordPartitionTransfers(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	rows := getRecordedRows([]config.ServiceStorageSummary{{ServiceName: "PartitionedCache", RemainingDistributionCount: 12}}, time.Now())
+	g.Expect(len(rows)).To(gomega.Equal(1))
+
+	last := rows[0][len(rows[0])-1]
+	g.Expect(last.name).To(gomega.Equal("remainingDistributionCount"))
+	g.Expect(last.value).To(gomega.Equal(int32(12)))
+}
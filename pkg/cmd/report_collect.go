@@ -0,0 +1,359 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	reportBatchColumn  = "BatchCounter"
+	reportTimeColumn   = "ReportTime"
+	reportMemberColumn = "ReportMember"
+	reportRowIDColumn  = "rowID"
+	reportFileTime     = "2006010215"
+)
+
+var (
+	reportOutputDirParam string
+	reportIntervalParam  int32
+	reportCountParam     int32
+	reportMaxFilesParam  int32
+
+	// reportGroups are the reports contained in the report group shipped with Coherence
+	reportGroups = map[string][]string{
+		"report-group": {"report-node", "report-network-health", "report-network-health-detail", "report-memory-summary",
+			"report-memory-status", "report-service", "report-proxy", "report-management", "report-cache-size"},
+	}
+
+	reportFilePattern = regexp.MustCompile(`^\d{10}-(.+)\.csv$`)
+)
+
+// collectReports runs reports on the members on an interval and appends the rows to a CSV file
+// per report, using hourly files named YYYYMMDDHH-report-name.csv.
+func collectReports(cmd *cobra.Command, dataFetcher fetcher.Fetcher, reportNames string) error {
+	var (
+		reports = getReportNames(reportNames)
+		warned  = make(map[string]bool)
+		nodeIDs []string
+		dropped []string
+		err     error
+	)
+
+	if reportIntervalParam <= 0 {
+		return errors.New("interval must be greater than zero")
+	}
+	if reportCountParam < 0 || reportMaxFilesParam < 0 {
+		return errors.New("count and max files must be greater or equal to zero")
+	}
+
+	if err = os.MkdirAll(reportOutputDirParam, 0750); err != nil {
+		return utils.GetError("unable to create directory "+reportOutputDirParam, err)
+	}
+
+	if reporterNodID != 0 {
+		nodeIDs = []string{strconv.Itoa(reporterNodID)}
+	} else if nodeIDs, err = GetClusterNodeIDs(dataFetcher); err != nil {
+		return err
+	}
+
+	cmd.Printf("Collecting reports %v from %d members every %d seconds to %s\n", reports, len(nodeIDs), reportIntervalParam, reportOutputDirParam)
+
+	for batch := 0; reportCountParam == 0 || batch < int(reportCountParam); batch++ {
+		if batch > 0 {
+			time.Sleep(time.Duration(reportIntervalParam) * time.Second)
+		}
+
+		now := time.Now()
+		for _, report := range reports {
+			rows, errorList := runReportOnMembers(dataFetcher, report, nodeIDs, batch)
+			for _, e := range errorList {
+				cmd.PrintErrf("Warning: %v\n", e)
+			}
+			if len(rows) == 0 {
+				continue
+			}
+
+			fileName := filepath.Join(reportOutputDirParam, fmt.Sprintf("%s-%s.csv", now.Format(reportFileTime), report))
+			if dropped, err = appendReportRows(fileName, rows); err != nil {
+				return err
+			}
+			if len(dropped) > 0 && !warned[fileName] {
+				cmd.PrintErrf("Warning: columns %v are not in the header of %s and were not written\n", dropped, fileName)
+				warned[fileName] = true
+			}
+
+			if err = removeOldReportFiles(reportOutputDirParam, report, int(reportMaxFilesParam)); err != nil {
+				return err
+			}
+		}
+
+		cmd.Printf("%s Batch %d complete\n", now.Format(time.DateTime), batch)
+	}
+
+	return nil
+}
+
+// runReportOnMembers runs a report on each of the members and returns the rows in member order.
+func runReportOnMembers(dataFetcher fetcher.Fetcher, report string, nodeIDs []string, batch int) ([][]recordedField, []error) {
+	var (
+		wg           sync.WaitGroup
+		mutex        sync.Mutex
+		errorList    = make([]error, 0)
+		memberRows   = make(map[string][][]recordedField)
+		rows         = make([][]recordedField, 0)
+		sortedMember = make([]string, len(nodeIDs))
+	)
+
+	wg.Add(len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		go func(nodeID string) {
+			defer wg.Done()
+			id, _ := strconv.Atoi(nodeID)
+			data, err := dataFetcher.RunReportJSON(report, id)
+			if err == nil {
+				var result [][]recordedField
+				if result, err = getReportRows(data, nodeID, batch); err == nil {
+					mutex.Lock()
+					memberRows[nodeID] = result
+					mutex.Unlock()
+					return
+				}
+			}
+			mutex.Lock()
+			errorList = append(errorList, err)
+			mutex.Unlock()
+		}(nodeID)
+	}
+	wg.Wait()
+
+	copy(sortedMember, nodeIDs)
+	sort.Slice(sortedMember, func(p, q int) bool {
+		a, _ := strconv.Atoi(sortedMember[p])
+		b, _ := strconv.Atoi(sortedMember[q])
+		return a < b
+	})
+
+	for _, nodeID := range sortedMember {
+		rows = append(rows, memberRows[nodeID]...)
+	}
+
+	return rows, errorList
+}
+
+// getReportRows flattens the items returned from running a report into rows, keeping the order of
+// the report columns. The batch counter and report time are moved to the start of each row, as they
+// are in the reporter output, and the member the report was run on is added to the end.
+func getReportRows(data []byte, nodeID string, batch int) ([][]recordedField, error) {
+	var (
+		rows   = make([][]recordedField, 0)
+		result = struct {
+			Items []json.RawMessage `json:"items"`
+		}{}
+	)
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, utils.GetError("unable to decode report", err)
+	}
+
+	for _, item := range result.Items {
+		fields, err := getOrderedFields(item)
+		if err != nil {
+			return nil, err
+		}
+
+		row := []recordedField{{name: reportBatchColumn, value: batch}}
+		var reportTime *recordedField
+		for i := range fields {
+			switch fields[i].name {
+			case reportBatchColumn, reportRowIDColumn:
+				continue
+			case reportTimeColumn:
+				reportTime = &fields[i]
+			default:
+				row = append(row, fields[i])
+			}
+		}
+		if reportTime != nil {
+			row = append([]recordedField{row[0], *reportTime}, row[1:]...)
+		}
+
+		rows = append(rows, append(row, recordedField{name: reportMemberColumn, value: nodeID}))
+	}
+
+	return rows, nil
+}
+
+// getOrderedFields returns the fields of a JSON object in the order they appear.
+func getOrderedFields(data []byte) ([]recordedField, error) {
+	var (
+		fields  = make([]recordedField, 0)
+		decoder = json.NewDecoder(bytes.NewReader(data))
+	)
+
+	decoder.UseNumber()
+
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, errors.New("unable to decode report row")
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value interface{}
+		if err = decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		if value == nil {
+			value = ""
+		}
+		fields = append(fields, recordedField{name: fmt.Sprintf("%v", token), value: value})
+	}
+
+	return fields, nil
+}
+
+// appendReportRows appends rows to a report file. The columns of a new file are taken from the rows,
+// otherwise the rows are aligned with the header of the existing file, and any columns which are
+// not in the header are not written and are returned.
+func appendReportRows(fileName string, rows [][]recordedField) ([]string, error) {
+	var (
+		header  []string
+		dropped = make([]string, 0)
+	)
+
+	newFile := true
+	if data, err := os.ReadFile(filepath.Clean(fileName)); err == nil && len(data) > 0 {
+		if header, err = csv.NewReader(bytes.NewReader(data)).Read(); err != nil {
+			return nil, utils.GetError("unable to read header from "+fileName, err)
+		}
+		newFile = false
+	} else {
+		header = getReportColumns(rows)
+	}
+
+	aligned := make([][]recordedField, 0, len(rows))
+	for _, row := range rows {
+		values := make(map[string]interface{}, len(row))
+		for _, f := range row {
+			values[f.name] = f.value
+			if !utils.SliceContains(header, f.name) && !utils.SliceContains(dropped, f.name) {
+				dropped = append(dropped, f.name)
+			}
+		}
+		alignedRow := make([]recordedField, 0, len(header))
+		for _, column := range header {
+			value, ok := values[column]
+			if !ok {
+				value = ""
+			}
+			alignedRow = append(alignedRow, recordedField{name: column, value: value})
+		}
+		aligned = append(aligned, alignedRow)
+	}
+
+	data, err := formatRecordedRowsCSV(aligned, newFile)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(filepath.Clean(fileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, utils.GetError("unable to open report file "+fileName, err)
+	}
+	defer file.Close()
+
+	if _, err = file.Write(data); err != nil {
+		return nil, utils.GetError("unable to write to report file "+fileName, err)
+	}
+
+	return dropped, nil
+}
+
+// getReportColumns returns the columns of all rows in the order they are first seen, with the
+// report member as the last column.
+func getReportColumns(rows [][]recordedField) []string {
+	columns := make([]string, 0)
+
+	for _, row := range rows {
+		for _, f := range row {
+			if f.name != reportMemberColumn && !utils.SliceContains(columns, f.name) {
+				columns = append(columns, f.name)
+			}
+		}
+	}
+
+	return append(columns, reportMemberColumn)
+}
+
+// removeOldReportFiles removes the oldest files for a report so that at most maxFiles remain.
+func removeOldReportFiles(directory, report string, maxFiles int) error {
+	if maxFiles == 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return err
+	}
+
+	files := make([]string, 0)
+	for _, entry := range entries {
+		matches := reportFilePattern.FindStringSubmatch(entry.Name())
+		if !entry.IsDir() && matches != nil && matches[1] == report {
+			files = append(files, entry.Name())
+		}
+	}
+
+	// the file names start with the time so sort in time order
+	sort.Strings(files)
+
+	for i := 0; i < len(files)-maxFiles; i++ {
+		if err = os.Remove(filepath.Join(directory, files[i])); err != nil {
+			return utils.GetError("unable to remove report file "+files[i], err)
+		}
+	}
+
+	return nil
+}
+
+// getReportNames returns the reports from a comma separated list of reports or report groups.
+func getReportNames(reportNames string) []string {
+	reports := make([]string, 0)
+
+	for _, v := range strings.Split(reportNames, ",") {
+		name := strings.TrimSuffix(strings.TrimSpace(v), ".xml")
+		names := []string{name}
+		if group, ok := reportGroups[name]; ok {
+			names = group
+		}
+		for _, report := range names {
+			if report != "" && !utils.SliceContains(reports, report) {
+				reports = append(reports, report)
+			}
+		}
+	}
+
+	return reports
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetReportRows(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	rows, err := getReportRows([]byte(`{"items":[{"RefreshTime":"Tue Oct 15 09:07:55 AWST 2024","ReportTime":"Tue Oct 15 09:07:56 AWST 2024",
+"RoleName":"CoherenceServer","MemoryMaxMB":512,"Cache":null,"BatchCounter":"0","rowID":3}]}`), "2", 5)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(rows)).To(gomega.Equal(1))
+	g.Expect(getReportColumns(rows)).To(gomega.Equal([]string{reportBatchColumn, reportTimeColumn, "RefreshTime",
+		"RoleName", "MemoryMaxMB", "Cache", reportMemberColumn}))
+
+	data, err := formatRecordedRowsCSV(rows, false)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.Equal("5,Tue Oct 15 09:07:56 AWST 2024,Tue Oct 15 09:07:55 AWST 2024,CoherenceServer,512,,2\n"))
+
+	_, err = getReportRows([]byte(`{"items":[1]}`), "1", 0)
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	g.Expect(getReportNames("report-node, report-group,report-proxy.xml")).To(gomega.Equal(append([]string{"report-node"},
+		reportGroups["report-group"][1:]...)))
+}
+
+func TestReportFiles(t *testing.T) {
+	var (
+		g         = gomega.NewGomegaWithT(t)
+		directory = t.TempDir()
+		fileName  = filepath.Join(directory, "2026101710-report-node.csv")
+	)
+
+	dropped, err := appendReportRows(fileName, [][]recordedField{{{name: "A", value: "1"}, {name: "B", value: 2},
+		{name: reportMemberColumn, value: "1"}}})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(dropped).To(gomega.BeEmpty())
+
+	// rows are aligned with the header of an existing file and new columns are returned
+	dropped, err = appendReportRows(fileName, [][]recordedField{{{name: "B", value: 3}, {name: "C", value: "x"},
+		{name: reportMemberColumn, value: "2"}}})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(dropped).To(gomega.Equal([]string{"C"}))
+
+	data, err := os.ReadFile(fileName)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.Equal("A,B,ReportMember\n1,2,1\n,3,2\n"))
+
+	for _, name := range []string{"2026101708-report-node.csv", "2026101709-report-node.csv", "2026101708-report-proxy.csv"} {
+		g.Expect(os.WriteFile(filepath.Join(directory, name), []byte("A\n"), 0600)).To(gomega.Succeed())
+	}
+
+	g.Expect(removeOldReportFiles(directory, "report-node", 2)).To(gomega.Succeed())
+	g.Expect(filepath.Join(directory, "2026101708-report-node.csv")).To(gomega.Not(gomega.BeAnExistingFile()))
+	g.Expect(filepath.Join(directory, "2026101709-report-node.csv")).To(gomega.BeARegularFile())
+	g.Expect(fileName).To(gomega.BeARegularFile())
+	g.Expect(filepath.Join(directory, "2026101708-report-proxy.csv")).To(gomega.BeARegularFile())
+}
@@ -8,6 +8,7 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/oracle/coherence-cli/pkg/config"
 	"github.com/oracle/coherence-cli/pkg/constants"
@@ -146,10 +147,13 @@ var describeReporterCmd = &cobra.Command{
 // runReportCmd represents the run report command.
 var runReportCmd = &cobra.Command{
 	Use:   "report report-name",
-	Short: "run a report and return the output",
+	Short: "run a report and return the output or collect the output to CSV files",
 	Long: `The 'run report' command runs a report on a specific node and returns the report output in JSON. 
 The report name should not include the .xml extension and will have the 'report' prefix added. E.g. 
-'report-node' will expand to 'reports/report-node.xml'. A HTTP 400 will be returned if the report name is not valid.`,
+'report-node' will expand to 'reports/report-node.xml'. A HTTP 400 will be returned if the report name is not valid.
+If you specify an output directory using '-O', the report is run on all members, or the node specified, 
+every interval and the rows are appended to hourly CSV files for each report, named YYYYMMDDHH-report-name.csv.
+You can specify a comma separated list of reports or the report group 'report-group'.`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
 			displayErrorAndExit(cmd, "you must provide a single report name")
@@ -170,19 +174,29 @@ The report name should not include the .xml extension and will have the 'report'
 			return err
 		}
 
-		// validate the nodeID
-		nodeIDArray, err := GetClusterNodeIDs(dataFetcher)
-		if err != nil {
-			return err
+		if reporterNodID == 0 && reportOutputDirParam == "" {
+			return errors.New("you must provide a node id or an output directory")
 		}
-		for _, v := range nodeIDArray {
-			i, _ := strconv.Atoi(v)
-			if i == reporterNodID {
-				found = true
+
+		// validate the nodeID
+		if reporterNodID != 0 {
+			nodeIDArray, err := GetClusterNodeIDs(dataFetcher)
+			if err != nil {
+				return err
+			}
+			for _, v := range nodeIDArray {
+				i, _ := strconv.Atoi(v)
+				if i == reporterNodID {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("unable to find node id %v", reporterNodID)
 			}
 		}
-		if !found {
-			return fmt.Errorf("unable to find node id %v", reporterNodID)
+
+		if reportOutputDirParam != "" {
+			return collectReports(cmd, dataFetcher, args[0])
 		}
 
 		jsonData, err = dataFetcher.RunReportJSON(args[0], reporterNodID)
@@ -366,5 +380,8 @@ func init() {
 	_ = setReporterCmd.MarkFlagRequired("value")
 
 	runReportCmd.Flags().IntVarP(&reporterNodID, "node", "n", 0, "node to run report on")
-	_ = runReportCmd.MarkFlagRequired("node")
+	runReportCmd.Flags().StringVarP(&reportOutputDirParam, "output-dir", "O", "", "directory to write CSV report files to")
+	runReportCmd.Flags().Int32VarP(&reportIntervalParam, "interval", "I", 60, "interval in seconds between running reports")
+	runReportCmd.Flags().Int32VarP(&reportCountParam, "count", "", 0, "number of times to run reports, 0 means until interrupted")
+	runReportCmd.Flags().Int32VarP(&reportMaxFilesParam, "max-files", "", 0, "maximum number of hourly files to keep for each report, 0 means keep all")
 }
@@ -0,0 +1,535 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	rollingRestartOperation  = "rolling-restart"
+	rollingShutdownOperation = "rolling-shutdown"
+	rollingPollInterval      = 5
+	rollingStopTimeout       = 60
+)
+
+var (
+	rollingStatusHAParam string
+	rollingTimeoutParam  int32
+	abortOnFailureParam  bool
+	resumeRollingParam   bool
+)
+
+// rollingState is the state of a rolling operation which is saved after each step
+// so that an operation which fails or is interrupted can be resumed.
+type rollingState struct {
+	Operation  string   `json:"operation"`
+	Connection string   `json:"connection"`
+	Completed  []string `json:"completed"`
+}
+
+// rollingStep is an individual step in a rolling operation.
+type rollingStep struct {
+	id          string
+	description string
+	action      func() error
+}
+
+// rollingRestartCmd represents the rolling-restart command.
+var rollingRestartCmd = &cobra.Command{
+	Use:   "rolling-restart",
+	Short: "restart a resource one member at a time",
+	Long:  `The 'rolling-restart' command restarts various resources one member at a time.`,
+}
+
+// rollingShutdownCmd represents the rolling-shutdown command.
+var rollingShutdownCmd = &cobra.Command{
+	Use:   "rolling-shutdown",
+	Short: "shutdown a resource one member at a time",
+	Long:  `The 'rolling-shutdown' command shuts down various resources one member at a time.`,
+}
+
+// rollingRestartClusterCmd represents the rolling-restart cluster command.
+var rollingRestartClusterCmd = &cobra.Command{
+	Use:   "cluster cluster-name",
+	Short: "restart a local Coherence cluster one member at a time",
+	Long: `The 'rolling-restart cluster' command restarts a cluster that was manually created one member
+at a time. Before restarting each member, the command waits until all members are present, every
+distributed service reports the StatusHA value specified by '-a' or safer and no partition transfers
+are remaining. Each member is sent SIGTERM and is only killed if it has not exited within 60 seconds.
+The member with management over REST enabled is restarted last. If a step does not complete within
+the timeout the operation is aborted, unless '--abort-on-failure=false' is specified. Use
+'--resume' to continue an operation that was aborted or interrupted.`,
+	ValidArgsFunction: completionAllManualClusters,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, youMustProviderConnectionMessage)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			connectionName = args[0]
+			dataFetcher    fetcher.Fetcher
+			members        []config.Member
+			steps          = make([]rollingStep, 0)
+			err            error
+		)
+
+		if err = validateRollingParams(); err != nil {
+			return err
+		}
+
+		// validate the Java executable are present and in the path
+		if err = checkRuntimeRequirements(); err != nil {
+			return err
+		}
+
+		if err = validateProfile(); err != nil {
+			return err
+		}
+
+		found, connection := GetClusterConnection(connectionName)
+		if !found {
+			return errors.New(UnableToFindClusterMsg + connectionName)
+		}
+
+		if err = checkOperation(connection, rollingRestartOperation); err != nil {
+			return err
+		}
+
+		dataFetcher, err = GetDataFetcher(connectionName)
+		if err != nil {
+			return err
+		}
+
+		members, err = getRollingMembers(dataFetcher)
+		if err != nil {
+			return err
+		}
+		if len(members) == 0 {
+			return fmt.Errorf("the cluster %s does not appear to be started", connection.Name)
+		}
+
+		var (
+			serverCount = int32(len(members))
+			processIDs  = make(map[string]int)
+			memberNames = getClusterMemberNames(connection, serverCount)
+		)
+
+		for _, m := range members {
+			if !utils.SliceContains(memberNames, m.MemberName) {
+				return fmt.Errorf("unable to determine how member %s of cluster %s was started", m.MemberName, connection.Name)
+			}
+			pid, _ := strconv.Atoi(m.ProcessName)
+			processIDs[m.MemberName] = pid
+		}
+
+		// the first member has management over REST enabled, so restart it last
+		memberNames = append(memberNames[1:], memberNames[0])
+
+		for _, v := range memberNames {
+			member := v
+			pid, ok := processIDs[member]
+			if !ok {
+				continue
+			}
+			steps = append(steps, rollingStep{id: member, description: "restarting member " + member,
+				action: func() error {
+					return restartRollingMember(cmd, connection, serverCount, member, pid)
+				}})
+		}
+
+		// if the logging destination has been set on the connection then override it
+		if connection.LoggingDestination != "" {
+			logsDirectory = connection.LoggingDestination
+		}
+
+		if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to perform a rolling restart of %d members for the cluster %s? (y/n) ",
+			len(steps), connection.Name)) {
+			return nil
+		}
+
+		return runRollingOperation(cmd, rollingRestartOperation, connection.Name, dataFetcher, len(members), steps)
+	},
+}
+
+// rollingShutdownClusterCmd represents the rolling-shutdown cluster command.
+var rollingShutdownClusterCmd = &cobra.Command{
+	Use:   "cluster",
+	Short: "shutdown the services of each member of a cluster one member at a time",
+	Long: `The 'rolling-shutdown cluster' command shuts down all the clustered services on each member
+of a cluster one member at a time, using the current context or a cluster specified by using '-c'.
+If the services were started using DefaultCacheServer, then they will be restarted. After each
+member, the command waits until every distributed service reports the StatusHA value specified by
+'-a' or safer and no partition transfers are remaining. If a step does not complete within the
+timeout the operation is aborted, unless '--abort-on-failure=false' is specified. Use '--resume'
+to continue an operation that was aborted or interrupted. Members which have been restarted since
+the operation was interrupted are shut down again when resuming.`,
+	Args: cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		var (
+			connection  string
+			dataFetcher fetcher.Fetcher
+			members     []config.Member
+			steps       = make([]rollingStep, 0)
+			err         error
+		)
+
+		if err = validateRollingParams(); err != nil {
+			return err
+		}
+
+		connection, dataFetcher, err = GetConnectionAndDataFetcher()
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(FormatCurrentCluster(connection))
+
+		members, err = getRollingMembers(dataFetcher)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range members {
+			nodeID := v.NodeID
+			steps = append(steps, rollingStep{id: getRollingMemberIdentity(v), description: fmt.Sprintf("shutting down member %s (%s)", nodeID, v.MemberName),
+				action: func() error {
+					_, err1 := dataFetcher.ShutdownMember(nodeID)
+					return err1
+				}})
+		}
+
+		if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to perform a rolling shutdown of %d members? (y/n) ", len(steps))) {
+			return nil
+		}
+
+		return runRollingOperation(cmd, rollingShutdownOperation, connection, dataFetcher, len(members), steps)
+	},
+}
+
+// getRollingMemberIdentity returns the identity of a member used to record the completed steps for a rolling
+// shutdown. Node ids are reused when members rejoin the cluster, so the member, machine and process
+// names are used to ensure that a member which has been restarted is not skipped when resuming.
+func getRollingMemberIdentity(member config.Member) string {
+	return fmt.Sprintf("%s/%s/%s", member.MemberName, member.MachineName, member.ProcessName)
+}
+
+// validateRollingParams validates the parameters for rolling operations.
+func validateRollingParams() error {
+	if !utils.SliceContains(validStatusHA, rollingStatusHAParam) {
+		return fmt.Errorf("the status-ha value must be one of %v", validStatusHA)
+	}
+	if rollingTimeoutParam <= 0 {
+		return errors.New("timeout must be greater than zero")
+	}
+	return nil
+}
+
+// getRollingMembers returns the members of a cluster sorted by node id.
+func getRollingMembers(dataFetcher fetcher.Fetcher) ([]config.Member, error) {
+	var members = config.Members{}
+
+	membersResult, err := dataFetcher.GetMemberDetailsJSON(false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(membersResult, &members); err != nil {
+		return nil, utils.GetError("unable to unmarshall members result", err)
+	}
+
+	sort.Slice(members.Members, func(p, q int) bool {
+		p1, _ := strconv.Atoi(members.Members[p].NodeID)
+		q1, _ := strconv.Atoi(members.Members[q].NodeID)
+		return p1 < q1
+	})
+
+	return members.Members, nil
+}
+
+// restartRollingMember stops the process for a member and starts it again. The process is asked
+// to exit gracefully and is only killed if it has not exited within rollingStopTimeout seconds.
+func restartRollingMember(cmd *cobra.Command, connection ClusterConnection, serverCount int32, member string, pid int) error {
+	// stop tracking the process first so a supervisor does not restart it
+	if err := untrackMemberProcesses(connection.Name, pid); err != nil {
+		return err
+	}
+
+	if err := stopMemberProcess(cmd, pid, member, rollingStopTimeout); err != nil {
+		return err
+	}
+
+	return restartClusterMember(cmd, connection, serverCount, member)
+}
+
+// stopMemberProcess terminates a member process and waits for it to exit, killing
+// the process if it has not exited within the timeout.
+func stopMemberProcess(cmd *cobra.Command, pid int, member string, timeout int) error {
+	if err := terminateProcess(pid); err != nil {
+		return utils.GetError(fmt.Sprintf("unable to stop process %d for member %s", pid, member), err)
+	}
+	cmd.Printf("stopping process %d\n", pid)
+
+	startTime := time.Now()
+	for isProcessRunning(pid) {
+		if time.Since(startTime) > time.Duration(timeout)*time.Second {
+			proc, err := os.FindProcess(pid)
+			if err == nil {
+				err = proc.Kill()
+			}
+			if err != nil {
+				return utils.GetError(fmt.Sprintf("unable to kill process %d for member %s", pid, member), err)
+			}
+			cmd.Printf("killed process %d as it did not stop within %d seconds\n", pid, timeout)
+			break
+		}
+		time.Sleep(time.Second)
+	}
+
+	return nil
+}
+
+// runRollingOperation runs the steps of a rolling operation one at a time, waiting for the
+// cluster to be safe before the first step and after each step.
+func runRollingOperation(cmd *cobra.Command, operation, connection string, dataFetcher fetcher.Fetcher,
+	expectedMembers int, steps []rollingStep) error {
+	var (
+		state  = rollingState{Operation: operation, Connection: connection, Completed: make([]string, 0)}
+		failed = make([]string, 0)
+		err    error
+	)
+
+	if resumeRollingParam {
+		if state, err = loadRollingState(operation, connection); err != nil {
+			return err
+		}
+		cmd.Printf("Resuming %s for %s, %d steps already completed\n", operation, connection, len(state.Completed))
+	}
+
+	cmd.Printf("Waiting for the cluster to be safe before starting %s\n", operation)
+	if err = waitForClusterSafe(cmd, dataFetcher, expectedMembers); err != nil {
+		return fmt.Errorf("%s not started: %v", operation, err)
+	}
+
+	for i, step := range steps {
+		if utils.SliceContains(state.Completed, step.id) {
+			cmd.Printf("Step %d/%d: skipping %s as it has already completed\n", i+1, len(steps), step.id)
+			continue
+		}
+
+		cmd.Printf("Step %d/%d: %s\n", i+1, len(steps), step.description)
+
+		if err = step.action(); err == nil {
+			time.Sleep(time.Duration(rollingPollInterval) * time.Second)
+			err = waitForClusterSafe(cmd, dataFetcher, expectedMembers)
+		}
+
+		if err != nil {
+			if abortOnFailureParam {
+				return fmt.Errorf("%s aborted at step %d/%d for %s: %v\nUse --resume to continue", operation, i+1, len(steps), step.id, err)
+			}
+			cmd.Printf("Step %d/%d failed for %s: %v\n", i+1, len(steps), step.id, err)
+			failed = append(failed, step.id)
+			continue
+		}
+
+		state.Completed = append(state.Completed, step.id)
+		if err = saveRollingState(state); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%s completed with failures for %v\nUse --resume to retry the failed steps", operation, failed)
+	}
+
+	if err = removeRollingState(operation, connection); err != nil {
+		return err
+	}
+
+	cmd.Printf("%s completed for %s\n", operation, connection)
+	return nil
+}
+
+// waitForClusterSafe waits until the cluster is safe or the timeout is reached. Errors
+// retrieving details are ignored until the timeout as the management member may be restarting.
+func waitForClusterSafe(cmd *cobra.Command, dataFetcher fetcher.Fetcher, expectedMembers int) error {
+	var (
+		startTime = time.Now()
+		reason    string
+	)
+
+	for {
+		reason = checkClusterSafe(dataFetcher, expectedMembers)
+		elapsedSeconds := int32(time.Since(startTime).Seconds())
+		if reason == "" {
+			cmd.Printf("Cluster is safe after %d seconds\n", elapsedSeconds)
+			return nil
+		}
+
+		if elapsedSeconds > rollingTimeoutParam {
+			return fmt.Errorf("cluster was not safe within %d seconds: %s", rollingTimeoutParam, reason)
+		}
+
+		cmd.Printf("Waiting for the cluster to be safe: %s\n", reason)
+		time.Sleep(time.Duration(rollingPollInterval) * time.Second)
+	}
+}
+
+// checkClusterSafe returns the reason the cluster is not safe or an empty string if it is safe.
+func checkClusterSafe(dataFetcher fetcher.Fetcher, expectedMembers int) string {
+	var servicesSummary = config.ServicesSummaries{}
+
+	members, err := getRollingMembers(dataFetcher)
+	if err != nil {
+		return fmt.Sprintf("unable to retrieve members: %v", err)
+	}
+
+	servicesResult, err := dataFetcher.GetServiceDetailsJSON()
+	if err != nil {
+		return fmt.Sprintf("unable to retrieve services: %v", err)
+	}
+
+	if err = json.Unmarshal(servicesResult, &servicesSummary); err != nil {
+		return fmt.Sprintf("unable to unmarshall service result: %v", err)
+	}
+
+	services := DeduplicateServices(servicesSummary, all)
+
+	transfers, err := getRemainingPartitionTransfers(dataFetcher, services)
+	if err != nil {
+		return fmt.Sprintf("unable to retrieve partition transfers: %v", err)
+	}
+
+	return getClusterSafetyReason(len(members), expectedMembers, services, transfers, rollingStatusHAParam)
+}
+
+// getRemainingPartitionTransfers returns the number of partition transfers remaining for all distributed services.
+func getRemainingPartitionTransfers(dataFetcher fetcher.Fetcher, services []config.ServiceSummary) (int32, error) {
+	var transfers int32
+
+	for _, v := range services {
+		if !utils.IsDistributedCache(v.ServiceType) {
+			continue
+		}
+
+		var data = config.ServiceStorageSummary{}
+		partitionsData, err := dataFetcher.GetServicePartitionsJSON(v.ServiceName)
+		if err != nil {
+			return 0, err
+		}
+		if len(partitionsData) == 0 {
+			continue
+		}
+		if err = json.Unmarshal(partitionsData, &data); err != nil {
+			return 0, utils.GetError("unable to unmarshall partition data", err)
+		}
+		transfers += data.RemainingDistributionCount
+	}
+
+	return transfers, nil
+}
+
+// getClusterSafetyReason returns the reason the cluster is not safe, or an empty string if all the
+// expected members are present, all services have reached the StatusHA or safer and there are no
+// partition transfers remaining.
+func getClusterSafetyReason(memberCount, expectedMembers int, services []config.ServiceSummary, transfers int32, statusHA string) string {
+	if memberCount < expectedMembers {
+		return fmt.Sprintf("%d of %d members are present", memberCount, expectedMembers)
+	}
+
+	statusHAValues := getStatusHAValues(services)
+	if len(statusHAValues) > 0 && !isStatusHAReached(statusHAValues, statusHA) {
+		return fmt.Sprintf("StatusHA values are %v, waiting for %s", statusHAValues, statusHA)
+	}
+
+	if transfers > 0 {
+		return fmt.Sprintf("%d partition transfers are remaining", transfers)
+	}
+
+	return ""
+}
+
+// getRollingStateFile returns the file the state of a rolling operation is saved to.
+func getRollingStateFile(operation, connection string) string {
+	return filepath.Join(cfgDirectory, fmt.Sprintf("%s-%s.json", operation, sanitizeConnectionName(connection)))
+}
+
+// loadRollingState loads the state of a previous rolling operation.
+func loadRollingState(operation, connection string) (rollingState, error) {
+	var (
+		state    rollingState
+		fileName = getRollingStateFile(operation, connection)
+	)
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, fmt.Errorf("there is no previous %s for %s to resume", operation, connection)
+		}
+		return state, utils.GetError("unable to read "+fileName, err)
+	}
+
+	if err = json.Unmarshal(data, &state); err != nil {
+		return state, utils.GetError("unable to unmarshall "+fileName, err)
+	}
+
+	return state, nil
+}
+
+// saveRollingState saves the state of a rolling operation.
+func saveRollingState(state rollingState) error {
+	fileName := getRollingStateFile(state.Operation, state.Connection)
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(fileName, data, 0600); err != nil {
+		return utils.GetError("unable to write "+fileName, err)
+	}
+
+	return nil
+}
+
+// removeRollingState removes the state of a completed rolling operation.
+func removeRollingState(operation, connection string) error {
+	fileName := getRollingStateFile(operation, connection)
+	if err := os.Remove(fileName); err != nil && !os.IsNotExist(err) {
+		return utils.GetError("unable to remove "+fileName, err)
+	}
+	return nil
+}
+
+// addRollingFlags adds the flags common to rolling operations.
+func addRollingFlags(command *cobra.Command) {
+	command.Flags().StringVarP(&rollingStatusHAParam, "status-ha", "a", "NODE-SAFE", "StatusHA value to wait for after each step")
+	command.Flags().Int32VarP(&rollingTimeoutParam, "timeout", "T", 300, "timeout in seconds to wait for the cluster to be safe after each step")
+	command.Flags().BoolVarP(&abortOnFailureParam, "abort-on-failure", "", true, "abort the operation if a step fails")
+	command.Flags().BoolVarP(&resumeRollingParam, "resume", "", false, "resume a previous operation that was aborted or interrupted")
+	command.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
+}
+
+func init() {
+	addRollingFlags(rollingRestartClusterCmd)
+	applyStartParams(rollingRestartClusterCmd)
+	addRollingFlags(rollingShutdownClusterCmd)
+}
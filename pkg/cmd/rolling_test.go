@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"testing"
+)
+
+func TestClusterSafetyReason(t *testing.T) {
+	var (
+		g        = gomega.NewGomegaWithT(t)
+		services = []config.ServiceSummary{
+			{ServiceName: "PartitionedCache", StatusHA: "NODE-SAFE"},
+			{ServiceName: "Proxy", StatusHA: "n/a"},
+		}
+	)
+
+	g.Expect(getClusterSafetyReason(3, 3, services, 0, "NODE-SAFE")).To(gomega.BeEmpty())
+	g.Expect(getClusterSafetyReason(2, 3, services, 0, "NODE-SAFE")).To(gomega.ContainSubstring("2 of 3 members"))
+	g.Expect(getClusterSafetyReason(3, 3, services, 0, "MACHINE-SAFE")).To(gomega.ContainSubstring("waiting for MACHINE-SAFE"))
+
+	g.Expect(getClusterSafetyReason(3, 3, services, 10, "NODE-SAFE")).To(gomega.ContainSubstring("10 partition transfers are remaining"))
+
+	// services with different StatusHA values are safe if each is at least as safe as the target
+	services = append(services, config.ServiceSummary{ServiceName: "OtherCache", StatusHA: "MACHINE-SAFE"})
+	g.Expect(getClusterSafetyReason(3, 3, services, 0, "NODE-SAFE")).To(gomega.BeEmpty())
+	g.Expect(getClusterSafetyReason(3, 3, services, 0, "MACHINE-SAFE")).To(gomega.ContainSubstring("waiting for MACHINE-SAFE"))
+
+	services = append(services, config.ServiceSummary{ServiceName: "ThirdCache", StatusHA: "ENDANGERED"})
+	g.Expect(getClusterSafetyReason(3, 3, services, 0, "NODE-SAFE")).To(gomega.Not(gomega.BeEmpty()))
+}
+
+func TestRollingState(t *testing.T) {
+	var (
+		g           = gomega.NewGomegaWithT(t)
+		originalDir = cfgDirectory
+	)
+
+	cfgDirectory = t.TempDir()
+	defer func() { cfgDirectory = originalDir }()
+
+	_, err := loadRollingState(rollingRestartOperation, "local")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	state := rollingState{Operation: rollingRestartOperation, Connection: "local", Completed: []string{"storage-1"}}
+	g.Expect(saveRollingState(state)).To(gomega.Succeed())
+
+	loaded, err := loadRollingState(rollingRestartOperation, "local")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(loaded.Completed).To(gomega.Equal([]string{"storage-1"}))
+
+	g.Expect(removeRollingState(rollingRestartOperation, "local")).To(gomega.Succeed())
+	_, err = loadRollingState(rollingRestartOperation, "local")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestRollingMemberIdentity(t *testing.T) {
+	var (
+		g         = gomega.NewGomegaWithT(t)
+		member    = config.Member{NodeID: "1", MemberName: "storage-0", MachineName: "host1", ProcessName: "8522@host1"}
+		restarted = config.Member{NodeID: "1", MemberName: "storage-0", MachineName: "host1", ProcessName: "9610@host1"}
+		other     = config.Member{NodeID: "1", MemberName: "storage-1", MachineName: "host1", ProcessName: "8522@host1"}
+	)
+
+	// a member which has rejoined with the same node id is not treated as the same member
+	g.Expect(getRollingMemberIdentity(member)).To(gomega.Equal("storage-0/host1/8522@host1"))
+	g.Expect(getRollingMemberIdentity(restarted)).To(gomega.Not(gomega.Equal(getRollingMemberIdentity(member))))
+	g.Expect(getRollingMemberIdentity(other)).To(gomega.Not(gomega.Equal(getRollingMemberIdentity(member))))
+}
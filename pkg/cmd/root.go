@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2021, 2025 Oracle and/or its affiliates.
+ * Copyright (c) 2021, 2026 Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * https://oss.oracle.com/licenses/upl.
  */
@@ -41,6 +41,7 @@ var (
 	limitOutput           bool
 	httpManagementURL     string
 	httpManagementCluster string
+	recordResponsesDir    string
 
 	bFormat  bool
 	kbFormat bool
@@ -81,6 +82,8 @@ const (
 	defaultHeapKey        = "defaultHeap"
 	profilesKey           = "profiles"
 	panelsKey             = "panels"
+	rulesKey              = "rules"
+	credentialsKey        = "credentials"
 
 	confirmOptionMessage     = "automatically confirm the operation"
 	timeoutMessage           = "timeout in seconds for NS Lookup requests"
@@ -194,6 +197,8 @@ type CoherenceCLIConfig struct {
 	UseGradle          bool                `json:"useGradle"`
 	Profiles           []ProfileValue      `mapstructure:"profiles"`
 	Panels             []Panel             `mapstructure:"panels"`
+	Rules              []Rule              `mapstructure:"rules"`
+	Credentials        []Credential        `mapstructure:"credentials"`
 	DefaultStyle       string              `json:"defaultStyle"`
 }
 
@@ -209,16 +214,41 @@ type Panel struct {
 	Layout string `json:"layout"`
 }
 
+// Rule describes a threshold rule evaluated by the get health, get services and monitor cluster commands.
+type Rule struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+// Credential describes a credential which can be used by cluster connections. The password or
+// token is stored in the encrypted credential store or retrieved using the command.
+type Credential struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"` // valid values are "basic", "token" or "cert"
+	Username   string `json:"username"`
+	Command    string `json:"command"`
+	ClientCert string `json:"clientCert"`
+	ClientKey  string `json:"clientKey"`
+}
+
 // ClusterConnection describes an individual connection to a cluster.
 type ClusterConnection struct {
 	Name                 string `json:"name"` // the name the user gives to the cluster connection
 	DiscoveryType        string `json:"discoveryType"`
-	ConnectionType       string `json:"connectionType"` // currently only valid value is "http"
+	ConnectionType       string `json:"connectionType"` // valid values are "http" or "replay"
 	ConnectionURL        string `json:"url"`
 	NameServiceDiscovery string `json:"nameServiceDiscovery"`
 	ClusterVersion       string `json:"clusterVersionParam"`
 	ClusterName          string `json:"clusterName"` // the actual cluster name
 	ClusterType          string `json:"clusterType"`
+	Credential           string `json:"credential"` // the name of the credential to use, if any
+
+	// the following attributes override the COHERENCE_TLS_* environment variables for the connection
+	TLSCACert     string `json:"tlsCACert"`
+	TLSClientCert string `json:"tlsClientCert"`
+	TLSClientKey  string `json:"tlsClientKey"`
+	TLSMinVersion string `json:"tlsMinVersion"`
+	TLSServerName string `json:"tlsServerName"`
 
 	// the following attributes are specific to manually created clusters
 	ManuallyCreated     bool   `json:"manuallyCreated"`     // indicates if this was created by the create cluster command
@@ -230,6 +260,9 @@ type ClusterConnection struct {
 	LoggingDestination  string `json:"loggingDestination"` // logging destination, if empty then place under ~/.cohctl/logs
 	ManagementAvailable bool   // only used when using -o wide option
 	StartupClass        string `json:"startupClass"`
+
+	// member groups to start if the cluster was created from a topology file
+	Topology []MemberGroup `json:"topology"`
 }
 
 // rootCmd represents the base command when called without any subcommands
@@ -243,6 +276,9 @@ func createRootCommand() *cobra.Command {
 		SilenceUsage: true,
 		Long: `The Coherence Command Line Interface (CLI) provides a way to
 interact with, and monitor Coherence clusters via a terminal-based interface.`,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			return validateRecordCommand(cmd)
+		},
 	}
 	return root
 }
@@ -274,6 +310,9 @@ func SetRootCommandFlags(command *cobra.Command) {
 	command.PersistentFlags().BoolVarP(&descendingFlag, "desc", "", false, "indicates descending sort for tables, default is ascending")
 	command.PersistentFlags().BoolVarP(&includePercentageBar, "percent-bar", "", false, includePercentDescription)
 	command.PersistentFlags().IntVarP(&percentageBarWidth, "percent-bar-width", "", 30, "set percentage bar width")
+	command.PersistentFlags().StringVarP(&recordResponsesDir, "record-responses", "", "", "directory to record all management responses to for later replay")
+	command.PersistentFlags().StringVarP(&recordFile, "record", "", "", "file to append each watched sample to (only available for get caches, cache-*, health, members, services and service-storage)")
+	command.PersistentFlags().StringVarP(&recordFormat, "record-format", "", "", "format of recorded samples, csv or ndjson (default is based on file extension)")
 
 	command.PersistentFlags().BoolVarP(&kbFormat, "kb", "k", false, "show sizes in kilobytes (default is bytes)")
 	command.PersistentFlags().BoolVarP(&mbFormat, "mb", "m", false, "show sizes in megabytes (default is bytes)")
@@ -343,6 +382,12 @@ func initConfig() {
 	fetcher.Logger = Logger
 	fetcher.UnableToFindClusterMsg = UnableToFindClusterMsg
 	fetcher.ReadPassStdin = readPassStdin
+	fetcher.RecordDirectory = recordResponsesDir
+
+	if err = validateRecordOptions(); err != nil {
+		rootCmd.Println(err)
+		os.Exit(1)
+	}
 	utils.Logger = Logger
 
 	fields := []zapcore.Field{
@@ -511,6 +556,14 @@ func Initialize(command *cobra.Command) *cobra.Command {
 	dumpRoleName = all
 	configureRole = ""
 	threadDumpRole = all
+	analyzeThreadDumps = false
+	cacheConfigFileParam = ""
+	snapshotDirectoriesParam = ""
+	forceRecoverParam = false
+	allServicesParam = false
+	jfrSessionParam = false
+	reportOutputDirParam = ""
+	reporterNodID = 0
 
 	Config.Clusters = make([]ClusterConnection, 0)
 
@@ -585,6 +638,11 @@ func Initialize(command *cobra.Command) *cobra.Command {
 	getCmd.AddCommand(getFederationIncomingCmd)
 	getCmd.AddCommand(getFederationOutgoingCmd)
 	getCmd.AddCommand(getPanelsCmd)
+	getCmd.AddCommand(getRulesCmd)
+	getCmd.AddCommand(getCredentialsCmd)
+	getCmd.AddCommand(getTLSCmd)
+	getCmd.AddCommand(getProcessesCmd)
+	getCmd.AddCommand(getCapacityCmd)
 	getCmd.AddCommand(getDefaultStyleCmd)
 
 	// set command
@@ -607,6 +665,8 @@ func Initialize(command *cobra.Command) *cobra.Command {
 	setCmd.AddCommand(setColorCmd)
 	setCmd.AddCommand(setClusterCmd)
 	setCmd.AddCommand(setDefaultStyleCmd)
+	setCmd.AddCommand(setCredentialCmd)
+	setCmd.AddCommand(setTLSCmd)
 
 	// run command
 	command.AddCommand(runCmd)
@@ -616,6 +676,25 @@ func Initialize(command *cobra.Command) *cobra.Command {
 	command.AddCommand(restartCmd)
 	restartCmd.AddCommand(restartClusterCmd)
 
+	// rolling-restart command
+	command.AddCommand(rollingRestartCmd)
+	rollingRestartCmd.AddCommand(rollingRestartClusterCmd)
+
+	// rolling-shutdown command
+	command.AddCommand(rollingShutdownCmd)
+	rollingShutdownCmd.AddCommand(rollingShutdownClusterCmd)
+
+	// supervise command
+	command.AddCommand(superviseCmd)
+	superviseCmd.AddCommand(superviseClusterCmd)
+
+	// analyze command
+	command.AddCommand(analyzeCmd)
+	analyzeCmd.AddCommand(analyzeLogsCmd)
+	analyzeCmd.AddCommand(analyzeThreadDumpsCmd)
+	analyzeCmd.AddCommand(analyzePartitionsCmd)
+	analyzeCmd.AddCommand(analyzeCacheCmd)
+
 	// clear
 	command.AddCommand(clearCmd)
 	clearCmd.AddCommand(clearContextCmd)
@@ -631,6 +710,8 @@ func Initialize(command *cobra.Command) *cobra.Command {
 	command.AddCommand(addCmd)
 	addCmd.AddCommand(addClusterCmd)
 	addCmd.AddCommand(addPanelCmd)
+	addCmd.AddCommand(addRuleCmd)
+	addCmd.AddCommand(addCredentialCmd)
 
 	// replicate
 	command.AddCommand(replicateCmd)
@@ -678,6 +759,8 @@ func Initialize(command *cobra.Command) *cobra.Command {
 	removeCmd.AddCommand(removeSnapshotCmd)
 	removeCmd.AddCommand(removeProfileCmd)
 	removeCmd.AddCommand(removePanelCmd)
+	removeCmd.AddCommand(removeRuleCmd)
+	removeCmd.AddCommand(removeCredentialCmd)
 
 	// describe
 	command.AddCommand(describeCmd)
@@ -696,12 +779,14 @@ func Initialize(command *cobra.Command) *cobra.Command {
 	describeCmd.AddCommand(describeFederationCmd)
 	describeCmd.AddCommand(describeTopicCmd)
 	describeCmd.AddCommand(describeViewCacheCmd)
+	describeCmd.AddCommand(describeCacheConfigCmd)
 
 	// create
 	command.AddCommand(createCmd)
 	createCmd.AddCommand(createSnapshotCmd)
 	createCmd.AddCommand(createClusterCmd)
 	createCmd.AddCommand(createStarterCmd)
+	createCmd.AddCommand(createDiagnosticBundleCmd)
 
 	// recover
 	command.AddCommand(recoverCmd)
@@ -771,6 +856,26 @@ func Initialize(command *cobra.Command) *cobra.Command {
 	command.AddCommand(compactCmd)
 	compactCmd.AddCommand(compactElasticDataCmd)
 
+	// diff
+	command.AddCommand(diffCmd)
+	diffCmd.AddCommand(diffClustersCmd)
+
+	// validate
+	command.AddCommand(validateCmd)
+	validateCmd.AddCommand(validateCacheConfigCmd)
+
+	// schedule
+	command.AddCommand(scheduleCmd)
+	scheduleCmd.AddCommand(scheduleSnapshotCmd)
+
+	// check
+	command.AddCommand(checkCmd)
+	checkCmd.AddCommand(checkSnapshotCmd)
+
+	// serve
+	command.AddCommand(serveCmd)
+	serveCmd.AddCommand(serveMetricsCmd)
+
 	// monitor
 	command.AddCommand(monitorCmd)
 	monitorCmd.AddCommand(monitorHealthCmd)
@@ -794,6 +899,9 @@ func Initialize(command *cobra.Command) *cobra.Command {
 func GetDataFetcher(clusterName string) (fetcher.Fetcher, error) {
 	var (
 		finalClusterName, finalConnectionURL, finalConnectionType string
+		auth                                                      *fetcher.Auth
+		tlsOptions                                                fetcher.TLSOptions
+		err                                                       error
 	)
 
 	// check to see if we have a ':' in the cluster, then we assume this is a host:port of
@@ -834,9 +942,17 @@ func GetDataFetcher(clusterName string) (fetcher.Fetcher, error) {
 		finalConnectionURL = connection.ConnectionURL
 		httpManagementURL = ""
 		httpManagementCluster = ""
+		tlsOptions = connection.getTLSOptions()
+
+		// use the credential for the connection unless a username has been specified
+		if connection.Credential != "" && Username == "" && finalConnectionType == httpType {
+			if auth, err = getCredentialAuth(connection.Credential); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	return fetcher.GetFetcherOrError(finalConnectionType, finalConnectionURL, Username, finalClusterName)
+	return fetcher.GetFetcherWithOptions(finalConnectionType, finalConnectionURL, Username, finalClusterName, auth, tlsOptions)
 }
 
 func randomize(arr []string) string {
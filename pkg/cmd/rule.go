@@ -0,0 +1,621 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	provideRuleName = "you must provide a single rule name"
+	ruleScopeMember = "member"
+	ruleScopeCache  = "cache"
+	ruleScopeSvc    = "service"
+	ruleAllTargets  = "*"
+	ruleStatusHA    = "statusHA"
+	ruleGrowth      = "growth"
+	percentSuffix   = "%"
+	perMinuteSuffix = "%/min"
+	ruleSamplesFile = "rule-samples.json"
+)
+
+var (
+	ruleExpression string
+
+	// ruleMetrics contains the valid metrics for each scope
+	ruleMetrics = map[string][]string{
+		ruleScopeSvc:    {ruleStatusHA, "endangered", "vulnerable", "unbalanced", "requestPending", "storageCount"},
+		ruleScopeCache:  {"size", "memory", ruleGrowth},
+		ruleScopeMember: {"publisherLoss", "receiverLoss", "memoryUsed"},
+	}
+
+	// ruleOperators contains the valid operators, with below and above as aliases
+	ruleOperators = map[string]string{
+		"<": "<", "<=": "<=", ">": ">", ">=": ">=", "=": "=", "!=": "!=", "below": "<", "above": ">",
+	}
+
+	// monitorCacheSizeSamples contains the previous cache sizes used by 'monitor cluster' to calculate growth rates
+	monitorCacheSizeSamples = make(map[string]cacheSizeSample)
+)
+
+// parsedRule contains a rule expression that has been validated.
+type parsedRule struct {
+	Name      string
+	Scope     string
+	Target    string
+	Metric    string
+	Operator  string
+	Threshold float64
+	StatusHA  string
+}
+
+// cacheSizeSample contains the size of a cache at a point in time.
+type cacheSizeSample struct {
+	Size int64     `json:"size"`
+	Time time.Time `json:"time"`
+}
+
+// RuleViolation describes a rule that has been violated by a target.
+type RuleViolation struct {
+	Cluster    string `json:"cluster,omitempty"`
+	Rule       string `json:"rule"`
+	Expression string `json:"expression"`
+	Scope      string `json:"scope"`
+	Target     string `json:"target"`
+	Value      string `json:"value"`
+}
+
+// addRuleCmd represents the add rule command.
+var addRuleCmd = &cobra.Command{
+	Use:   "rule rule-name",
+	Short: "add a threshold rule to evaluate against clusters",
+	Long: `The 'add rule' command adds a threshold rule which is evaluated by the 'get health',
+'get services' and 'monitor cluster' commands. A rule expression has the format
+'scope target metric operator threshold', where the scope is 'service', 'cache' or 'member'
+and the target is a service name, service/cache name, node id or '*' for all.
+Operators are <, <=, >, >=, =, != or 'below' and 'above'. Valid metrics are:
+  service: statusHA, endangered, vulnerable, unbalanced, requestPending, storageCount
+  cache:   size, memory, growth (percent per minute, e.g. 10%/min)
+  member:  publisherLoss, receiverLoss, memoryUsed (percent, e.g. 1%)
+Cache sizes are saved in the config directory so growth can be calculated between invocations.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, provideRuleName)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			ruleName = args[0]
+			err      error
+			rules    = Config.Rules
+		)
+
+		// validate rule name
+		if err = validateName("rule", ruleName); err != nil {
+			return err
+		}
+
+		if getRuleExpression(ruleName) != "" {
+			return fmt.Errorf("the rule '%s' already exists", ruleName)
+		}
+
+		if _, err = parseRule(Rule{Name: ruleName, Expression: ruleExpression}); err != nil {
+			return err
+		}
+
+		// confirm the operation
+		if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to add the rule %s with expression of [%s]? (y/n) ", ruleName, ruleExpression)) {
+			return nil
+		}
+
+		rules = append(rules, Rule{Name: ruleName, Expression: ruleExpression})
+
+		viper.Set(rulesKey, rules)
+		err = WriteConfig()
+		if err != nil {
+			return err
+		}
+		cmd.Printf("rule %s was added with expression [%s]\n", ruleName, ruleExpression)
+		return nil
+	},
+}
+
+// removeRuleCmd represents the remove rule command.
+var removeRuleCmd = &cobra.Command{
+	Use:               "rule rule-name",
+	Short:             "remove a rule from the list of rules",
+	Long:              `The 'remove rule' command removes a rule from the list of rules.`,
+	ValidArgsFunction: completionAllRules,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, provideRuleName)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			ruleName = args[0]
+			err      error
+		)
+
+		if getRuleExpression(ruleName) == "" {
+			return fmt.Errorf("a rule with the name %s does not exist", ruleName)
+		}
+
+		// confirm the operation
+		if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to remove the rule %s? (y/n) ", ruleName)) {
+			return nil
+		}
+
+		newRules := make([]Rule, 0)
+
+		// loop though the list of rules
+		for _, v := range Config.Rules {
+			if v.Name != ruleName {
+				newRules = append(newRules, v)
+			}
+		}
+
+		viper.Set(rulesKey, newRules)
+		err = WriteConfig()
+		if err != nil {
+			return err
+		}
+		cmd.Printf("rule %s was removed\n", ruleName)
+		return nil
+	},
+}
+
+// getRulesCmd represents the get rules command.
+var getRulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "display the rules that have been created",
+	Long:  `The 'get rules' displays the rules that have been created.`,
+	Args:  cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cmd.Println(FormatRules(Config.Rules))
+		return nil
+	},
+}
+
+func init() {
+	addRuleCmd.Flags().StringVarP(&ruleExpression, "expression", "e", "", "rule expression")
+	_ = addRuleCmd.MarkFlagRequired("expression")
+	addRuleCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
+
+	removeRuleCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
+}
+
+// getRuleExpression returns the expression for a given rule or "" if the rule doesn't exist.
+func getRuleExpression(ruleName string) string {
+	for _, v := range Config.Rules {
+		if v.Name == ruleName {
+			return v.Expression
+		}
+	}
+
+	return ""
+}
+
+// parseRule parses and validates a rule expression.
+func parseRule(rule Rule) (parsedRule, error) {
+	var (
+		result = parsedRule{Name: rule.Name}
+		fields = strings.Fields(rule.Expression)
+		ok     bool
+	)
+
+	if len(fields) != 5 {
+		return result, fmt.Errorf("rule expression [%s] must have the format 'scope target metric operator threshold'", rule.Expression)
+	}
+
+	result.Scope, result.Target, result.Metric = fields[0], fields[1], fields[2]
+
+	metrics, ok := ruleMetrics[result.Scope]
+	if !ok {
+		return result, fmt.Errorf("invalid scope '%s', must be one of %s, %s or %s", result.Scope, ruleScopeSvc, ruleScopeCache, ruleScopeMember)
+	}
+
+	if !utils.SliceContains(metrics, result.Metric) {
+		return result, fmt.Errorf("invalid metric '%s' for scope %s, must be one of %v", result.Metric, result.Scope, metrics)
+	}
+
+	if result.Scope == ruleScopeCache && result.Target != ruleAllTargets && !strings.Contains(result.Target, "/") {
+		return result, fmt.Errorf("cache target '%s' must be in the format service/cache or '*'", result.Target)
+	}
+
+	if result.Operator, ok = ruleOperators[fields[3]]; !ok {
+		return result, fmt.Errorf("invalid operator '%s'", fields[3])
+	}
+
+	threshold := fields[4]
+	if result.Metric == ruleStatusHA {
+		if !utils.SliceContains(allStatusHA, threshold) {
+			return result, fmt.Errorf("invalid statusHA threshold '%s', must be one of %v", threshold, allStatusHA)
+		}
+		result.StatusHA = threshold
+		return result, nil
+	}
+
+	threshold = strings.TrimSuffix(strings.TrimSuffix(threshold, perMinuteSuffix), percentSuffix)
+	value, err := strconv.ParseFloat(threshold, 64)
+	if err != nil {
+		return result, fmt.Errorf("invalid threshold '%s' for rule %s", fields[4], rule.Name)
+	}
+	result.Threshold = value
+
+	return result, nil
+}
+
+// getParsedRules returns all the rules from the config that are valid.
+func getParsedRules() ([]parsedRule, error) {
+	var rules = make([]parsedRule, 0, len(Config.Rules))
+
+	for _, rule := range Config.Rules {
+		parsed, err := parseRule(rule)
+		if err != nil {
+			return nil, utils.GetError("invalid rule "+rule.Name, err)
+		}
+		rules = append(rules, parsed)
+	}
+
+	return rules, nil
+}
+
+// getRulesBaseData returns the data that must be retrieved by retrieveClusterSummary to evaluate the rules.
+func getRulesBaseData(rules []parsedRule) []string {
+	var baseData = make([]string, 0)
+
+	for _, rule := range rules {
+		data := servicesPanelData
+		if rule.Scope == ruleScopeMember {
+			data = memberPanelData
+		}
+		if !utils.SliceContains(baseData, data) {
+			baseData = append(baseData, data)
+		}
+	}
+
+	return baseData
+}
+
+// evaluateRules evaluates the rules against the cluster summary and returns any violations.
+// The samples contain the previous cache sizes and are updated with the current sizes.
+func evaluateRules(rules []parsedRule, clusterSummary clusterSummaryInfo, samples map[string]cacheSizeSample) ([]RuleViolation, error) {
+	var (
+		violations = make([]RuleViolation, 0)
+		services   = config.ServicesSummaries{}
+		members    = config.Members{}
+		growth     = getCacheGrowthRates(samples, clusterSummary.cacheSummaryDetail, time.Now())
+	)
+
+	if len(clusterSummary.servicesResult) > 0 {
+		if err := json.Unmarshal(clusterSummary.servicesResult, &services); err != nil {
+			return nil, utils.GetError("unable to unmarshall service result", err)
+		}
+	}
+
+	if len(clusterSummary.membersResult) > 0 {
+		if err := json.Unmarshal(clusterSummary.membersResult, &members); err != nil {
+			return nil, utils.GetError("unable to unmarshall members result", err)
+		}
+	}
+
+	deDuplicatedServices := DeduplicateServices(services, all)
+
+	for _, rule := range rules {
+		add := func(target, value string) {
+			violations = append(violations, RuleViolation{Rule: rule.Name, Scope: rule.Scope, Target: target, Value: value,
+				Expression: fmt.Sprintf("%s %s %s %s", rule.Scope, rule.Target, rule.Metric, getRuleThreshold(rule))})
+		}
+
+		switch rule.Scope {
+		case ruleScopeSvc:
+			for _, service := range deDuplicatedServices {
+				if !ruleTargetMatches(rule, service.ServiceName) {
+					continue
+				}
+				if rule.Metric == ruleStatusHA {
+					// only services with partitions have a statusHA
+					if utils.SliceContains(allStatusHA, service.StatusHA) && compareStatusHA(service.StatusHA, rule.Operator, rule.StatusHA) {
+						add(service.ServiceName, service.StatusHA)
+					}
+					continue
+				}
+				if value := getServiceMetric(service, rule.Metric); compareRuleValue(value, rule.Operator, rule.Threshold) {
+					add(service.ServiceName, formatRuleValue(value))
+				}
+			}
+		case ruleScopeCache:
+			for _, cache := range clusterSummary.cacheSummaryDetail {
+				key := cache.ServiceName + "/" + cache.CacheName
+				if !ruleTargetMatches(rule, key) {
+					continue
+				}
+				var value float64
+				switch rule.Metric {
+				case ruleGrowth:
+					rate, ok := growth[key]
+					if !ok {
+						// no previous sample to compare with
+						continue
+					}
+					value = rate
+				case "memory":
+					value = float64(cache.UnitsBytes)
+				default:
+					value = float64(cache.CacheSize)
+				}
+				if compareRuleValue(value, rule.Operator, rule.Threshold) {
+					add(key, formatRuleValue(value))
+				}
+			}
+		case ruleScopeMember:
+			for _, member := range members.Members {
+				if !ruleTargetMatches(rule, member.NodeID) {
+					continue
+				}
+				if value := getMemberMetric(member, rule.Metric); compareRuleValue(value, rule.Operator, rule.Threshold) {
+					add(member.NodeID, formatRuleValue(value))
+				}
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+// checkRules retrieves the data required and evaluates all the rules in the config, returning
+// any violations. If no rules are defined then no data is retrieved. The cache sizes are saved
+// for the connection so growth rates can be calculated across separate invocations.
+func checkRules(connection string, dataFetcher fetcher.Fetcher) ([]RuleViolation, error) {
+	if len(Config.Rules) == 0 {
+		return nil, nil
+	}
+
+	rules, err := getParsedRules()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterSummary, errorList := retrieveClusterSummary(dataFetcher, getRulesBaseData(rules)...)
+	if len(errorList) > 0 {
+		return nil, utils.GetErrors(errorList)
+	}
+
+	allSamples, err := loadCacheSizeSamples()
+	if err != nil {
+		return nil, err
+	}
+
+	samples, ok := allSamples[connection]
+	if !ok {
+		samples = make(map[string]cacheSizeSample)
+		allSamples[connection] = samples
+	}
+
+	violations, err := evaluateRules(rules, clusterSummary, samples)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = saveCacheSizeSamples(allSamples); err != nil {
+		return nil, err
+	}
+
+	return violations, nil
+}
+
+// checkMultiClusterRules evaluates the rules against each of the clusters that a fetcher
+// was created for, setting the cluster for each violation.
+func checkMultiClusterRules(fetchers []clusterFetcher) ([]RuleViolation, error) {
+	var violations = make([]RuleViolation, 0)
+
+	for _, f := range fetchers {
+		if f.err != nil {
+			continue
+		}
+		clusterViolations, err := checkRules(f.connection, f.dataFetcher)
+		if err != nil {
+			return nil, utils.GetError("unable to evaluate rules for cluster connection "+f.connection, err)
+		}
+		for _, v := range clusterViolations {
+			v.Cluster = f.connection
+			violations = append(violations, v)
+		}
+	}
+
+	return violations, nil
+}
+
+// displayRuleViolations evaluates the rules and displays any violations if the output
+// format is not JSON.
+func displayRuleViolations(cmd *cobra.Command, connection string, dataFetcher fetcher.Fetcher) ([]RuleViolation, error) {
+	violations, err := checkRules(connection, dataFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(violations) > 0 && !isJSONPathOrJSON() {
+		cmd.Println(FormatRuleViolations(violations))
+	}
+
+	return violations, nil
+}
+
+// getRuleViolationsError returns an error if there are rule violations.
+func getRuleViolationsError(violations []RuleViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d rule violation(s) found", len(violations))
+}
+
+// getCacheGrowthRates returns the growth rate in percent per minute for each cache since the
+// previous sample, and saves the current sizes as the new samples.
+func getCacheGrowthRates(samples map[string]cacheSizeSample, caches []config.CacheSummaryDetail, now time.Time) map[string]float64 {
+	var rates = make(map[string]float64)
+
+	for _, cache := range caches {
+		key := cache.ServiceName + "/" + cache.CacheName
+		current := cacheSizeSample{Size: int64(cache.CacheSize), Time: now}
+		if previous, ok := samples[key]; ok && previous.Size > 0 {
+			if minutes := now.Sub(previous.Time).Minutes(); minutes > 0 {
+				rates[key] = float64(current.Size-previous.Size) / float64(previous.Size) * 100 / minutes
+			}
+		}
+		samples[key] = current
+	}
+
+	return rates
+}
+
+// getRuleSamplesFile returns the file the cache size samples are saved to.
+func getRuleSamplesFile() string {
+	return filepath.Join(cfgDirectory, ruleSamplesFile)
+}
+
+// loadCacheSizeSamples loads the saved cache size samples keyed by connection and service/cache.
+func loadCacheSizeSamples() (map[string]map[string]cacheSizeSample, error) {
+	var (
+		samples  = make(map[string]map[string]cacheSizeSample)
+		fileName = getRuleSamplesFile()
+	)
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return samples, nil
+		}
+		return nil, utils.GetError("unable to read "+fileName, err)
+	}
+
+	if err = json.Unmarshal(data, &samples); err != nil {
+		return nil, utils.GetError("unable to unmarshal "+fileName, err)
+	}
+
+	return samples, nil
+}
+
+// saveCacheSizeSamples saves the cache size samples, writing to a temporary file first so the
+// samples file is replaced atomically.
+func saveCacheSizeSamples(samples map[string]map[string]cacheSizeSample) error {
+	fileName := getRuleSamplesFile()
+
+	data, err := json.Marshal(samples)
+	if err != nil {
+		return utils.GetError("unable to marshal cache size samples", err)
+	}
+
+	tempFile := fileName + ".tmp"
+	if err = os.WriteFile(tempFile, data, 0600); err != nil {
+		return utils.GetError("unable to write "+tempFile, err)
+	}
+
+	if err = os.Rename(tempFile, fileName); err != nil {
+		return utils.GetError("unable to rename "+tempFile, err)
+	}
+
+	return nil
+}
+
+// ruleTargetMatches returns true if the rule target matches the given name.
+func ruleTargetMatches(rule parsedRule, name string) bool {
+	return rule.Target == ruleAllTargets || rule.Target == name
+}
+
+// getServiceMetric returns the value of a numeric service metric.
+func getServiceMetric(service config.ServiceSummary, metric string) float64 {
+	switch metric {
+	case "endangered":
+		return float64(service.PartitionsEndangered)
+	case "vulnerable":
+		return float64(service.PartitionsVulnerable)
+	case "unbalanced":
+		return float64(service.PartitionsUnbalanced)
+	case "requestPending":
+		return float64(service.RequestPendingCount)
+	default:
+		return float64(service.StorageEnabledCount)
+	}
+}
+
+// getMemberMetric returns the value of a member metric as a percentage.
+func getMemberMetric(member config.Member, metric string) float64 {
+	switch metric {
+	case "publisherLoss":
+		return (1 - float64(member.PublisherSuccessRate)) * 100
+	case "receiverLoss":
+		return (1 - float64(member.ReceiverSuccessRate)) * 100
+	default:
+		if member.MemoryMaxMB == 0 {
+			return 0
+		}
+		return float64(member.MemoryMaxMB-member.MemoryAvailableMB) / float64(member.MemoryMaxMB) * 100
+	}
+}
+
+// compareRuleValue returns true if the value compared to the threshold using the operator is true.
+func compareRuleValue(value float64, operator string, threshold float64) bool {
+	switch operator {
+	case "<":
+		return value < threshold
+	case "<=":
+		return value <= threshold
+	case ">":
+		return value > threshold
+	case ">=":
+		return value >= threshold
+	case "=":
+		return value == threshold
+	default:
+		return value != threshold
+	}
+}
+
+// compareStatusHA compares statusHA values where a safer statusHA is greater.
+func compareStatusHA(value, operator, threshold string) bool {
+	return compareRuleValue(float64(utils.GetSliceIndex(allStatusHA, value)), operator,
+		float64(utils.GetSliceIndex(allStatusHA, threshold)))
+}
+
+// getRuleThreshold returns the threshold of a rule for display.
+func getRuleThreshold(rule parsedRule) string {
+	if rule.Metric == ruleStatusHA {
+		return rule.Operator + " " + rule.StatusHA
+	}
+	return rule.Operator + " " + formatRuleValue(rule.Threshold)
+}
+
+// formatRuleValue formats a value for display in a rule violation.
+func formatRuleValue(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+// getRuleViolationTargets returns the targets of the violations for the given scope.
+func getRuleViolationTargets(violations []RuleViolation, scope string) []string {
+	var targets = make([]string, 0)
+	for _, v := range violations {
+		if v.Scope == scope && !utils.SliceContains(targets, v.Target) {
+			targets = append(targets, v.Target)
+		}
+	}
+	return targets
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"testing"
+	"time"
+)
+
+func TestParseRule(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	rule, err := parseRule(Rule{Name: "ha", Expression: "service PartitionedCache statusHA below NODE-SAFE"})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(rule.Operator).To(gomega.Equal("<"))
+	g.Expect(rule.StatusHA).To(gomega.Equal("NODE-SAFE"))
+
+	rule, err = parseRule(Rule{Name: "growth", Expression: "cache * growth > 10%/min"})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(rule.Threshold).To(gomega.Equal(10.0))
+
+	for _, expression := range []string{"service * statusHA < SAFE", "member * heap > 1", "cache test size > 1",
+		"service * endangered ~ 1", "member * publisherLoss > x", "service *"} {
+		_, err = parseRule(Rule{Name: "invalid", Expression: expression})
+		g.Expect(err).To(gomega.HaveOccurred())
+	}
+}
+
+func TestEvaluateRules(t *testing.T) {
+	var (
+		g     = gomega.NewGomegaWithT(t)
+		rules = make([]parsedRule, 0)
+	)
+
+	for _, expression := range []string{"service * statusHA below NODE-SAFE", "member * publisherLoss > 1%",
+		"service Proxy storageCount > 0"} {
+		rule, err := parseRule(Rule{Name: "rule", Expression: expression})
+		g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		rules = append(rules, rule)
+	}
+
+	summary := clusterSummaryInfo{
+		servicesResult: []byte(`{"items":[{"name":"PartitionedCache","statusHA":"ENDANGERED"},{"name":"Other","statusHA":"NODE-SAFE"},
+			{"name":"Proxy","statusHA":"n/a"}]}`),
+		membersResult: []byte(`{"items":[{"nodeId":"1","publisherSuccessRate":0.98},{"nodeId":"2","publisherSuccessRate":1.0}]}`),
+	}
+
+	violations, err := evaluateRules(rules, summary, make(map[string]cacheSizeSample))
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(violations)).To(gomega.Equal(2))
+	g.Expect(violations[0].Target).To(gomega.Equal("PartitionedCache"))
+	g.Expect(violations[1].Target).To(gomega.Equal("1"))
+	g.Expect(violations[1].Value).To(gomega.Equal("2"))
+}
+
+func TestGetCacheGrowthRates(t *testing.T) {
+	var (
+		g       = gomega.NewGomegaWithT(t)
+		now     = time.Now()
+		cache   = config.CacheSummaryDetail{ServiceName: "growth-test", CacheName: "cache", CacheSize: 100}
+		samples = make(map[string]cacheSizeSample)
+	)
+
+	// first sample returns no rates
+	g.Expect(len(getCacheGrowthRates(samples, []config.CacheSummaryDetail{cache}, now))).To(gomega.Equal(0))
+
+	cache.CacheSize = 120
+	rates := getCacheGrowthRates(samples, []config.CacheSummaryDetail{cache}, now.Add(2*time.Minute))
+	g.Expect(rates["growth-test/cache"]).To(gomega.BeNumerically("~", 10.0, 0.001))
+}
+
+func TestCacheSizeSamplesArePersisted(t *testing.T) {
+	var (
+		g        = gomega.NewGomegaWithT(t)
+		now      = time.Now().Truncate(time.Second)
+		previous = cfgDirectory
+	)
+
+	cfgDirectory = t.TempDir()
+	defer func() { cfgDirectory = previous }()
+
+	samples, err := loadCacheSizeSamples()
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(samples)).To(gomega.Equal(0))
+
+	samples["local"] = map[string]cacheSizeSample{"PartitionedCache/test": {Size: 100, Time: now}}
+	g.Expect(saveCacheSizeSamples(samples)).To(gomega.Succeed())
+
+	// a separate invocation loads the previous sample and can calculate the growth rate
+	samples, err = loadCacheSizeSamples()
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(samples["local"]["PartitionedCache/test"].Size).To(gomega.Equal(int64(100)))
+	g.Expect(samples["local"]["PartitionedCache/test"].Time.Equal(now)).To(gomega.BeTrue())
+
+	rates := getCacheGrowthRates(samples["local"], []config.CacheSummaryDetail{{ServiceName: "PartitionedCache",
+		CacheName: "test", CacheSize: 150}}, now.Add(time.Minute))
+	g.Expect(rates["PartitionedCache/test"]).To(gomega.BeNumerically("~", 50.0, 0.001))
+}
+
+func TestValidateName(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	g.Expect(validateName("rule", "high-memory")).To(gomega.Succeed())
+	g.Expect(validateName("rule", "bad name!")).To(gomega.MatchError(gomega.HavePrefix("rule name bad name!")))
+	g.Expect(validateName("credential", "bad name!")).To(gomega.MatchError(gomega.HavePrefix("credential name bad name!")))
+}
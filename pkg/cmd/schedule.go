@@ -0,0 +1,18 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// scheduleCmd represents the schedule command.
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "schedule an operation",
+	Long:  `The 'schedule' command schedules operations to run periodically.`,
+}
@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	metricsPrefix      = "cohctl_"
+	metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+	gauge              = "gauge"
+)
+
+var (
+	metricsPort    int32
+	metricsAddress string
+)
+
+// exportedMetric describes a single metric and its samples in Prometheus text format.
+type exportedMetric struct {
+	name    string
+	help    string
+	samples []metricSample
+}
+
+// metricSample contains the labels and value for a metric sample.
+type metricSample struct {
+	labels [][2]string
+	value  float64
+}
+
+// metricsExporter holds the last collected metrics for serving.
+type metricsExporter struct {
+	sync.RWMutex
+	dataFetcher fetcher.Fetcher
+	output      string
+}
+
+// serveMetricsCmd represents the serve metrics command.
+var serveMetricsCmd = &cobra.Command{
+	Use:   "metrics",
+	Short: "serve metrics derived from management over REST in Prometheus format",
+	Long: `The 'serve metrics' command periodically retrieves information for a cluster and exposes
+derived values such as service StatusHA, cache sizes, publisher and receiver success rates,
+federation backlog and persistence snapshot counts on a /metrics endpoint in Prometheus text format.
+This allows clusters that do not have Coherence metrics enabled to be scraped by Prometheus.
+The metrics are refreshed using the delay specified by '-d'.`,
+	Args: cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		var (
+			err         error
+			dataFetcher fetcher.Fetcher
+			connection  string
+		)
+
+		connection, dataFetcher, err = GetConnectionAndDataFetcher()
+		if err != nil {
+			return err
+		}
+
+		exporter := &metricsExporter{dataFetcher: dataFetcher}
+
+		// collect initially so any connection errors are returned immediately
+		if err = exporter.collect(); err != nil {
+			return err
+		}
+
+		go func() {
+			for {
+				time.Sleep(time.Duration(watchDelay) * time.Second)
+				if err1 := exporter.collect(); err1 != nil {
+					Logger.Warn("Unable to collect metrics", zap.Error(err1))
+				}
+			}
+		}()
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/metrics", exporter.serveHTTP)
+
+		address := fmt.Sprintf("%s:%d", metricsAddress, metricsPort)
+		cmd.Printf("Serving metrics for connection %s on http://%s/metrics, refreshing every %d seconds\n",
+			connection, address, watchDelay)
+
+		server := &http.Server{Addr: address, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		return server.ListenAndServe()
+	},
+}
+
+func init() {
+	serveMetricsCmd.Flags().Int32VarP(&metricsPort, "port", "p", 9620, "port to serve metrics on")
+	serveMetricsCmd.Flags().StringVarP(&metricsAddress, "address", "a", localhost, "address to serve metrics on")
+}
+
+// serveHTTP returns the last collected metrics.
+func (m *metricsExporter) serveHTTP(w http.ResponseWriter, _ *http.Request) {
+	m.RLock()
+	defer m.RUnlock()
+
+	w.Header().Set("Content-Type", metricsContentType)
+	_, _ = w.Write([]byte(m.output))
+}
+
+// collect retrieves the cluster information and saves the formatted metrics. If the collection
+// fails then only the up metric is reported as 0.
+func (m *metricsExporter) collect() error {
+	metrics, err := collectMetrics(m.dataFetcher)
+
+	up := 1.0
+	if err != nil {
+		up = 0
+		metrics = nil
+	}
+	metrics = append(metrics, exportedMetric{name: "up", help: "whether the last collection was successful",
+		samples: []metricSample{{value: up}}})
+
+	m.Lock()
+	defer m.Unlock()
+	m.output = FormatPrometheusMetrics(metrics)
+
+	return err
+}
+
+// collectMetrics retrieves the cluster information and returns the derived metrics.
+func collectMetrics(dataFetcher fetcher.Fetcher) ([]exportedMetric, error) {
+	var (
+		cluster  = config.Cluster{}
+		services = config.ServicesSummaries{}
+		members  = config.Members{}
+	)
+
+	clusterSummary, errorList := retrieveClusterSummary(dataFetcher, servicesPanelData, memberPanelData, federationPanelData)
+	if len(errorList) > 0 {
+		return nil, utils.GetErrors(errorList)
+	}
+
+	if err := json.Unmarshal(clusterSummary.clusterResult, &cluster); err != nil {
+		return nil, utils.GetError("unable to unmarshall cluster result", err)
+	}
+
+	if err := json.Unmarshal(clusterSummary.servicesResult, &services); err != nil {
+		return nil, utils.GetError("unable to unmarshall service result", err)
+	}
+
+	if err := json.Unmarshal(clusterSummary.membersResult, &members); err != nil {
+		return nil, utils.GetError("unable to unmarshall members result", err)
+	}
+
+	persistenceServices := DeduplicatePersistenceServices(services)
+	if err := processPersistenceServices(persistenceServices, dataFetcher); err != nil {
+		return nil, err
+	}
+
+	return getDerivedMetrics(cluster.ClusterName, DeduplicateServices(services, all), persistenceServices,
+		members.Members, clusterSummary.cacheSummaryDetail, clusterSummary.finalSummariesDestinations), nil
+}
+
+// getDerivedMetrics returns the metrics derived from the cluster information.
+func getDerivedMetrics(clusterName string, services, persistenceServices []config.ServiceSummary, members []config.Member,
+	caches []config.CacheSummaryDetail, federation []config.FederationSummary) []exportedMetric {
+	var (
+		metrics = make(map[string]*exportedMetric)
+		names   = make([]string, 0)
+	)
+
+	add := func(name, help string, value float64, labels ...string) {
+		metric, ok := metrics[name]
+		if !ok {
+			metric = &exportedMetric{name: name, help: help}
+			metrics[name] = metric
+			names = append(names, name)
+		}
+		sample := metricSample{labels: [][2]string{{"cluster", clusterName}}, value: value}
+		for i := 0; i+1 < len(labels); i += 2 {
+			sample.labels = append(sample.labels, [2]string{labels[i], labels[i+1]})
+		}
+		metric.samples = append(metric.samples, sample)
+	}
+
+	add("cluster_size", "number of members in the cluster", float64(len(members)))
+
+	for _, s := range services {
+		if index := utils.GetSliceIndex(allStatusHA, s.StatusHA); index >= 0 {
+			add("service_status_ha", "service StatusHA where 0=ENDANGERED, 1=NODE-SAFE, 2=MACHINE-SAFE, 3=RACK-SAFE, 4=SITE-SAFE",
+				float64(index), "service", s.ServiceName)
+			add("service_partitions_endangered", "number of endangered partitions", float64(s.PartitionsEndangered), "service", s.ServiceName)
+			add("service_partitions_vulnerable", "number of vulnerable partitions", float64(s.PartitionsVulnerable), "service", s.ServiceName)
+			add("service_partitions_unbalanced", "number of unbalanced partitions", float64(s.PartitionsUnbalanced), "service", s.ServiceName)
+		}
+		add("service_storage_enabled_count", "number of storage enabled members", float64(s.StorageEnabledCount), "service", s.ServiceName)
+		add("service_member_count", "number of members running the service", float64(s.MemberCount), "service", s.ServiceName)
+	}
+
+	for _, c := range caches {
+		add("cache_size", "number of entries in the cache", float64(c.CacheSize), "service", c.ServiceName, "cache", c.CacheName)
+		add("cache_units_bytes", "bytes used by the cache", float64(c.UnitsBytes), "service", c.ServiceName, "cache", c.CacheName)
+	}
+
+	for _, m := range members {
+		add("member_publisher_success_rate", "member publisher success rate", float64(m.PublisherSuccessRate),
+			"node_id", m.NodeID, "member", m.MemberName, "machine", m.MachineName)
+		add("member_receiver_success_rate", "member receiver success rate", float64(m.ReceiverSuccessRate),
+			"node_id", m.NodeID, "member", m.MemberName, "machine", m.MachineName)
+		add("member_memory_max_mb", "member maximum heap in MB", float64(m.MemoryMaxMB),
+			"node_id", m.NodeID, "member", m.MemberName, "machine", m.MachineName)
+		add("member_memory_available_mb", "member available heap in MB", float64(m.MemoryAvailableMB),
+			"node_id", m.NodeID, "member", m.MemberName, "machine", m.MachineName)
+	}
+
+	for _, f := range federation {
+		add("federation_record_backlog_delay_millis", "maximum record backlog delay time percentile in millis",
+			f.RecordBacklogDelayTimePercentileMillis.Max, "service", f.ServiceName, "participant", f.ParticipantName)
+		add("federation_replicate_all_partitions_unacked", "total replicate all partitions not acknowledged",
+			f.TotalReplicateAllPartitionsUnacked.Sum, "service", f.ServiceName, "participant", f.ParticipantName)
+		add("federation_bytes_sent", "total bytes sent", f.TotalBytesSent.Sum, "service", f.ServiceName, "participant", f.ParticipantName)
+	}
+
+	for _, p := range persistenceServices {
+		add("persistence_snapshot_count", "number of snapshots for the service", float64(len(p.Snapshots)), "service", p.ServiceName)
+	}
+
+	sort.Strings(names)
+	result := make([]exportedMetric, 0, len(names))
+	for _, name := range names {
+		result = append(result, *metrics[name])
+	}
+
+	return result
+}
+
+// labelValueReplacer escapes the only characters the Prometheus text format requires escaping in label values.
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabelValue escapes a label value for the Prometheus text format. Other characters,
+// including non-ASCII and tabs, are valid UTF-8 and are written as is.
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
+
+// FormatPrometheusMetrics returns the metrics in Prometheus text format.
+func FormatPrometheusMetrics(metrics []exportedMetric) string {
+	var sb strings.Builder
+
+	for _, metric := range metrics {
+		name := metricsPrefix + metric.name
+		sb.WriteString(fmt.Sprintf("# HELP %s %s\n# TYPE %s %s\n", name, metric.help, name, gauge))
+		for _, sample := range metric.samples {
+			sb.WriteString(name)
+			if len(sample.labels) > 0 {
+				labels := make([]string, 0, len(sample.labels))
+				for _, l := range sample.labels {
+					labels = append(labels, fmt.Sprintf(`%s="%s"`, l[0], escapeLabelValue(l[1])))
+				}
+				sb.WriteString("{" + strings.Join(labels, ",") + "}")
+			}
+			sb.WriteString(" " + strconv.FormatFloat(sample.value, 'g', -1, 64) + "\n")
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"strings"
+	"testing"
+)
+
+func TestGetDerivedMetrics(t *testing.T) {
+	var (
+		g        = gomega.NewGomegaWithT(t)
+		services = []config.ServiceSummary{
+			{ServiceName: "PartitionedCache", StatusHA: "MACHINE-SAFE", StorageEnabledCount: 2},
+			{ServiceName: "Proxy", StatusHA: "n/a"},
+		}
+		persistence = []config.ServiceSummary{{ServiceName: "PartitionedCache", Snapshots: []string{"snap1", "snap2"}}}
+		members     = []config.Member{{NodeID: "1", MemberName: "m1", PublisherSuccessRate: 0.5}}
+		caches      = []config.CacheSummaryDetail{{ServiceName: "PartitionedCache", CacheName: "test", CacheSize: 10}}
+	)
+
+	output := FormatPrometheusMetrics(getDerivedMetrics("cluster1", services, persistence, members, caches, nil))
+
+	g.Expect(output).To(gomega.ContainSubstring("# TYPE cohctl_service_status_ha gauge\n"))
+	g.Expect(output).To(gomega.ContainSubstring(`cohctl_service_status_ha{cluster="cluster1",service="PartitionedCache"} 2` + "\n"))
+	g.Expect(output).To(gomega.ContainSubstring(`cohctl_cache_size{cluster="cluster1",service="PartitionedCache",cache="test"} 10` + "\n"))
+	g.Expect(output).To(gomega.ContainSubstring(`cohctl_member_publisher_success_rate{cluster="cluster1",node_id="1",member="m1",machine=""} 0.5` + "\n"))
+	g.Expect(output).To(gomega.ContainSubstring(`cohctl_persistence_snapshot_count{cluster="cluster1",service="PartitionedCache"} 2` + "\n"))
+	g.Expect(output).To(gomega.ContainSubstring(`cohctl_cluster_size{cluster="cluster1"} 1` + "\n"))
+
+	// services without a StatusHA should not have a status metric
+	g.Expect(strings.Contains(output, `cohctl_service_status_ha{cluster="cluster1",service="Proxy"}`)).To(gomega.BeFalse())
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	g.Expect(escapeLabelValue("cache")).To(gomega.Equal("cache"))
+	g.Expect(escapeLabelValue("café-données")).To(gomega.Equal("café-données"))
+	g.Expect(escapeLabelValue("a\tb")).To(gomega.Equal("a\tb"))
+	g.Expect(escapeLabelValue(`a\b`)).To(gomega.Equal(`a\\b`))
+	g.Expect(escapeLabelValue(`say "hi"`)).To(gomega.Equal(`say \"hi\"`))
+	g.Expect(escapeLabelValue("line1\nline2")).To(gomega.Equal(`line1\nline2`))
+
+	output := FormatPrometheusMetrics(getDerivedMetrics("cluster1", nil, nil, nil,
+		[]config.CacheSummaryDetail{{ServiceName: "Service\t1", CacheName: "café", CacheSize: 1}}, nil))
+	g.Expect(output).To(gomega.ContainSubstring("cohctl_cache_size{cluster=\"cluster1\",service=\"Service\t1\",cache=\"café\"} 1\n"))
+}
@@ -53,7 +53,10 @@ var getServicesCmd = &cobra.Command{
 	Short: "display services for a cluster",
 	Long: `The 'get services' command displays services for a cluster using various options. 
 You may specify the service type as well a status-ha value to wait for. You
-can also specify '-o wide' to display addition information.`,
+can also specify '-o wide' to display addition information. If any rules have been
+added using 'add rule', they are evaluated and any violations cause a non-zero exit code.
+Specify a comma separated list of connections, or 'all', using '--clusters' to display
+the services of multiple clusters.`,
 	Args: cobra.ExactArgs(0),
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		var (
@@ -63,6 +66,10 @@ can also specify '-o wide' to display addition information.`,
 			connection     string
 		)
 
+		if clusterConnections != "" && statusHAType != "none" {
+			return fmt.Errorf("you cannot specify a status-ha value with --%s", clustersOption)
+		}
+
 		if statusHAType != "none" {
 			if !isWatchEnabled() {
 				return errors.New("if you have specified a status-ha value then you must enable watch option")
@@ -77,11 +84,21 @@ can also specify '-o wide' to display addition information.`,
 			return fmt.Errorf("invalid service type of '%s' specified. \nValid types are %v", serviceType, validServiceTypes)
 		}
 
+		if clusterConnections != "" {
+			return runMultiClusterCommand(cmd, retrieveClusterServices, FormatMultiClusterServices, true)
+		}
+
 		connection, dataFetcher, err = GetConnectionAndDataFetcher()
 		if err != nil {
 			return err
 		}
 
+		// rules are evaluated once, so they do not slow down or abort waiting for a status-ha value
+		violations, err := checkRules(connection, dataFetcher)
+		if err != nil {
+			return err
+		}
+
 		startTime := time.Now()
 
 		for {
@@ -108,30 +125,32 @@ can also specify '-o wide' to display addition information.`,
 
 				deDuplicatedServices := DeduplicateServices(servicesSummary, serviceType)
 
+				if err = recordWatchSample(deDuplicatedServices); err != nil {
+					return err
+				}
+
 				printWatchHeader(cmd)
 
 				cmd.Println(FormatCurrentCluster(connection))
 				cmd.Println(FormatServices(deDuplicatedServices))
 
 				// collect all the statusHA values
-				statusHAValues = make([]string, 0)
-				for _, value := range deDuplicatedServices {
-					// ignore n/a
-					if value.StatusHA != "n/a" && !utils.SliceContains(statusHAValues, value.StatusHA) {
-						statusHAValues = append(statusHAValues, value.StatusHA)
-					}
+				statusHAValues = getStatusHAValues(deDuplicatedServices)
+
+				if len(violations) > 0 {
+					cmd.Println(FormatRuleViolations(violations))
 				}
 			}
 
 			// check to see if we should exit if we are not watching
 			if !isWatchEnabled() {
-				break
+				return getRuleViolationsError(violations)
 			}
 
 			// if we have specified a statusHA value to wait for then process this
 			if statusHAType != "none" {
 				elapsedSeconds := int32(time.Since(startTime).Seconds())
-				if len(statusHAValues) == 1 && isStatusHASaferThan(statusHAValues[0], statusHAType) {
+				if isStatusHAReached(statusHAValues, statusHAType) {
 					cmd.Printf("Status HA value of %s or better reached in %d seconds for service types of '%s'\n",
 						statusHAType, elapsedSeconds, serviceType)
 					return nil
@@ -149,8 +168,6 @@ can also specify '-o wide' to display addition information.`,
 			// we are watching so sleep and then repeat until CTRL-C
 			time.Sleep(time.Duration(watchDelay) * time.Second)
 		}
-
-		return nil
 	},
 }
 
@@ -197,6 +214,10 @@ information regarding partition sizes.`,
 					cmd.Println(string(jsonData))
 				}
 			} else {
+				if err = recordWatchSample(storageSummary); err != nil {
+					return err
+				}
+
 				printWatchHeader(cmd)
 
 				cmd.Println(FormatCurrentCluster(connection))
@@ -1284,6 +1305,31 @@ func issueServiceCommand(cmd *cobra.Command, serviceName, operation string) erro
 	return nil
 }
 
+// getStatusHAValues returns the distinct StatusHA values for services, ignoring n/a.
+func getStatusHAValues(services []config.ServiceSummary) []string {
+	statusHAValues := make([]string, 0)
+	for _, value := range services {
+		if value.StatusHA != "n/a" && !utils.SliceContains(statusHAValues, value.StatusHA) {
+			statusHAValues = append(statusHAValues, value.StatusHA)
+		}
+	}
+	return statusHAValues
+}
+
+// isStatusHAReached returns true if all services have a StatusHA value which
+// is the same or safer than the statusHAType.
+func isStatusHAReached(statusHAValues []string, statusHAType string) bool {
+	if len(statusHAValues) == 0 {
+		return false
+	}
+	for _, value := range statusHAValues {
+		if !isStatusHASaferThan(value, statusHAType) {
+			return false
+		}
+	}
+	return true
+}
+
 // isStatusHASaferThan returns true if the statusHaValue is safer that the safestStatusHAValue.
 func isStatusHASaferThan(statusHAValue, safestStatusHAValue string) bool {
 	thisIndex := utils.GetSliceIndex(allStatusHA, statusHAValue)
@@ -1338,6 +1384,7 @@ Invocation, Proxy, RemoteCache or ReplicatedCache`)
 	getServicesCmd.Flags().StringVarP(&statusHAType, "status-ha", "a", "none",
 		"statusHA to wait for. Used in conjunction with -T option")
 	getServicesCmd.Flags().Int32VarP(&statusHATimeout, "timeout", "T", 60, "timeout to wait for StatusHA value of all services")
+	getServicesCmd.Flags().StringVarP(&clusterConnections, clustersOption, "", "", clustersDescription)
 
 	setServiceCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
 	setServiceCmd.Flags().StringVarP(&attributeNameService, "attribute", "a", "", "attribute name to set")
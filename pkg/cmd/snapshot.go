@@ -39,9 +39,14 @@ const (
 // createSnapshotCmd represents the create snapshot command.
 var createSnapshotCmd = &cobra.Command{
 	Use:   snapshotUse,
-	Short: "create a snapshot for a given service",
+	Short: "create a snapshot for a given service or group of services",
 	Long: `The 'create snapshot' command creates a snapshot for a given service. If you 
-do not specify the -y option you will be prompted to confirm the operation.`,
+do not specify the -y option you will be prompted to confirm the operation.
+If you specify a comma separated list of services or --all-services, the services
+are suspended and a snapshot with the same name is created and verified for each
+service, waiting up to the timeout specified by '-T' for each snapshot. If any snapshot
+fails, the snapshots already created are removed. The services are always resumed,
+except for any services that were already suspended, which are left suspended.`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
 			displayErrorAndExit(cmd, provideSnapshot)
@@ -49,6 +54,9 @@ do not specify the -y option you will be prompted to confirm the operation.`,
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if allServicesParam || strings.Contains(serviceName, ",") {
+			return createServicesSnapshot(cmd, args[0])
+		}
 		return invokePersistenceOperation(cmd, fetcher.CreateSnapshot, args[0], false)
 	},
 }
@@ -187,7 +195,9 @@ var recoverSnapshotCmd = &cobra.Command{
 	Short: "recover a snapshot for a given service",
 	Long: `The 'recover snapshot' command recovers a snapshot for a given service. 
 WARNING: Issuing this command will destroy all service data and replaced with the
-data from the requested snapshot.`,
+data from the requested snapshot. The snapshot is checked to ensure it covers all partitions by
+scanning the snapshot directories specified using '-D'. If partitions are missing, '--force' must be
+specified to recover the snapshot. If the directories are not specified, a warning is displayed.`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
 			displayErrorAndExit(cmd, provideSnapshot)
@@ -195,6 +205,9 @@ data from the requested snapshot.`,
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := checkRecoverSnapshotCoverage(cmd, args[0]); err != nil {
+			return err
+		}
 		return invokePersistenceOperation(cmd, fetcher.RecoverSnapshot, args[0], true)
 	},
 }
@@ -271,7 +284,10 @@ This may lead to the partial or full data loss of the corresponding cache servic
 }
 
 func init() {
-	setPersistenceFlags(createSnapshotCmd)
+	createSnapshotCmd.Flags().StringVarP(&serviceName, serviceNameOption, serviceNameOptionShort, "", "service name or comma separated list of service names")
+	createSnapshotCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
+	createSnapshotCmd.Flags().BoolVarP(&allServicesParam, "all-services", "", false, "create the snapshot for all persistence services")
+	createSnapshotCmd.Flags().Int32VarP(&persistenceTimeoutParam, "timeout", "T", 600, "timeout in seconds to wait for each snapshot when creating snapshots for a group of services")
 	setPersistenceFlags(recoverSnapshotCmd)
 	setPersistenceFlags(archiveSnapshotCmd)
 	setPersistenceFlags(retrieveSnapshotCmd)
@@ -0,0 +1,332 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	snapshotDirectoriesParam string
+	forceRecoverParam        bool
+
+	// storeGUIDPattern matches a persistent store identifier of partition, version, time and member
+	storeGUIDPattern = regexp.MustCompile(`^(\d+)-(\d+)-([0-9a-fA-F]+)-(\d+)$`)
+)
+
+// snapshotMemberCoverage is the number of partitions in a snapshot that were stored by a member.
+type snapshotMemberCoverage struct {
+	MemberID   int `json:"memberId"`
+	Partitions int `json:"partitions"`
+}
+
+// snapshotCoverage is the result of checking the partitions covered by a snapshot.
+type snapshotCoverage struct {
+	ServiceName       string                   `json:"serviceName"`
+	SnapshotName      string                   `json:"snapshotName"`
+	Archived          bool                     `json:"archived"`
+	Exists            bool                     `json:"exists"`
+	Idle              bool                     `json:"idle"`
+	OperationStatus   string                   `json:"operationStatus"`
+	PartitionCount    int                      `json:"partitionCount"`
+	StoresFound       bool                     `json:"storesFound"`
+	Stores            int                      `json:"stores"`
+	CoveredPartitions int                      `json:"coveredPartitions"`
+	MissingPartitions []int                    `json:"missingPartitions"`
+	Members           []snapshotMemberCoverage `json:"members"`
+	Complete          bool                     `json:"complete"`
+}
+
+// checkSnapshotCmd represents the check snapshot command.
+var checkSnapshotCmd = &cobra.Command{
+	Use:   snapshotUse,
+	Short: "check a snapshot covers all partitions for a service",
+	Long: `The 'check snapshot' command checks that a snapshot or archived snapshot exists and that the
+stores in the snapshot cover all partitions for the service, and reports any partitions that are
+missing. The stores for an archived snapshot are retrieved from the archiver. The stores for a local
+snapshot are found by scanning the snapshot directories specified using '-D', which must be accessible
+from where the command is run, for example on a shared filesystem or for a local cluster.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, provideSnapshot)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var snapshotName = utils.SanitizeSnapshotName(args[0])
+
+		connection, dataFetcher, err := GetConnectionAndDataFetcher()
+		if err != nil {
+			return err
+		}
+
+		coverage, err := getSnapshotCoverage(dataFetcher, serviceName, snapshotName, ArchivedSnapshots, getSnapshotDirectories())
+		if err != nil {
+			return err
+		}
+
+		if isJSONPathOrJSON() {
+			jsonData, err := json.Marshal(coverage)
+			if err != nil {
+				return err
+			}
+			if err = processJSONOutput(cmd, jsonData); err != nil {
+				return err
+			}
+		} else {
+			cmd.Println(FormatCurrentCluster(connection))
+			cmd.Println(FormatSnapshotCoverage(coverage))
+		}
+
+		if !coverage.Exists {
+			return fmt.Errorf("snapshot named %s does not exist for service %s", snapshotName, serviceName)
+		}
+
+		if coverage.StoresFound && !coverage.Complete {
+			return fmt.Errorf("snapshot %s is missing %d of %d partitions", snapshotName,
+				len(coverage.MissingPartitions), coverage.PartitionCount)
+		}
+
+		return nil
+	},
+}
+
+// checkRecoverSnapshotCoverage checks the coverage of a snapshot before it is recovered, and returns
+// an error if partitions are missing from the snapshot unless the recovery is forced. A warning is
+// displayed if the coverage cannot be verified.
+func checkRecoverSnapshotCoverage(cmd *cobra.Command, snapshotName string) error {
+	_, dataFetcher, err := GetConnectionAndDataFetcher()
+	if err != nil {
+		return err
+	}
+
+	coverage, err := getSnapshotCoverage(dataFetcher, serviceName, utils.SanitizeSnapshotName(snapshotName), false, getSnapshotDirectories())
+	if err != nil {
+		return err
+	}
+
+	message, err := getRecoverCoverageMessage(coverage, forceRecoverParam)
+	if err != nil {
+		return err
+	}
+	if message != "" {
+		cmd.Println(message)
+	}
+
+	return nil
+}
+
+// getRecoverCoverageMessage returns the message to display for the coverage of a snapshot before it is
+// recovered, or an error if the snapshot is not complete and force is false.
+func getRecoverCoverageMessage(coverage snapshotCoverage, force bool) (string, error) {
+	if !coverage.Exists {
+		// reported by the recover operation
+		return "", nil
+	}
+
+	if !coverage.StoresFound {
+		return fmt.Sprintf("Warning: unable to verify the partitions covered by snapshot %s, use '-D' to specify the snapshot directories",
+			coverage.SnapshotName), nil
+	}
+
+	if coverage.Complete {
+		return fmt.Sprintf("Snapshot %s covers all %d partitions", coverage.SnapshotName, coverage.PartitionCount), nil
+	}
+
+	message := fmt.Sprintf("snapshot %s is missing %d of %d partitions: %s", coverage.SnapshotName,
+		len(coverage.MissingPartitions), coverage.PartitionCount, utils.FormatPartitions(coverage.MissingPartitions))
+
+	if !force {
+		return "", fmt.Errorf("%s, use --force to recover the snapshot with data loss", message)
+	}
+
+	return "Warning: " + message, nil
+}
+
+// getSnapshotCoverage returns the coverage of a local or archived snapshot for a service.
+func getSnapshotCoverage(dataFetcher fetcher.Fetcher, service, snapshotName string, archived bool, directories []string) (snapshotCoverage, error) {
+	var (
+		coordinator = config.PersistenceCoordinator{}
+		coverage    = snapshotCoverage{ServiceName: service, SnapshotName: snapshotName, Archived: archived,
+			MissingPartitions: make([]int, 0), Members: make([]snapshotMemberCoverage, 0)}
+		stores = make([]string, 0)
+	)
+
+	servicesSummary, err := GetServices(dataFetcher)
+	if err != nil {
+		return coverage, err
+	}
+
+	for _, value := range servicesSummary.Services {
+		if value.ServiceName == service && utils.IsDistributedCache(value.ServiceType) {
+			coverage.PartitionCount = int(value.PartitionsAll)
+		}
+	}
+
+	if coverage.PartitionCount == 0 {
+		return coverage, fmt.Errorf("cannot find persistence service named %s", service)
+	}
+
+	coordData, err := dataFetcher.GetPersistenceCoordinator(service)
+	if err != nil {
+		return coverage, err
+	}
+	if len(coordData) > 0 {
+		if err = json.Unmarshal(coordData, &coordinator); err != nil {
+			return coverage, utils.GetError("unable to decode persistence coordinator", err)
+		}
+	}
+	coverage.Idle = coordinator.Idle
+	coverage.OperationStatus = coordinator.OperationStatus
+
+	if archived {
+		snapshots, err := GetArchivedSnapshots(dataFetcher, service)
+		if err != nil {
+			return coverage, err
+		}
+		coverage.Exists = utils.SliceContains(snapshots, snapshotName)
+
+		if coverage.Exists {
+			var archiveStores = config.ArchiveStores{}
+			data, err := dataFetcher.GetArchivedSnapshotStores(service, snapshotName)
+			if err != nil {
+				return coverage, err
+			}
+			if len(data) > 0 {
+				if err = json.Unmarshal(data, &archiveStores); err != nil {
+					return coverage, utils.GetError("unable to decode archive stores", err)
+				}
+				coverage.StoresFound = true
+				stores = archiveStores.Stores
+			}
+		}
+	} else {
+		coverage.Exists = utils.SliceContains(coordinator.Snapshots, snapshotName)
+
+		if coverage.Exists && len(directories) > 0 {
+			if stores, err = getSnapshotDirectoryStores(directories, snapshotName); err != nil {
+				return coverage, err
+			}
+			coverage.StoresFound = true
+		}
+	}
+
+	calculateSnapshotCoverage(&coverage, stores)
+
+	return coverage, nil
+}
+
+// getSnapshotDirectoryStores returns the stores found in the directories for a snapshot. A store is
+// a directory named as a store identifier, with the snapshot name as one of its parent directories.
+func getSnapshotDirectoryStores(directories []string, snapshotName string) ([]string, error) {
+	stores := make([]string, 0)
+
+	for _, directory := range directories {
+		absDirectory, err := filepath.Abs(directory)
+		if err != nil {
+			return nil, err
+		}
+		if stat, err := os.Stat(absDirectory); err != nil || !stat.IsDir() {
+			return nil, fmt.Errorf("snapshot directory %s does not exist", directory)
+		}
+
+		err = filepath.WalkDir(absDirectory, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || !entry.IsDir() || !storeGUIDPattern.MatchString(entry.Name()) {
+				return err
+			}
+			if utils.SliceContains(strings.Split(filepath.Dir(path), string(filepath.Separator)), snapshotName) {
+				stores = append(stores, entry.Name())
+			}
+			return filepath.SkipDir
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stores, nil
+}
+
+// calculateSnapshotCoverage calculates the partitions covered by the stores of a snapshot,
+// and the number of partitions stored by each member.
+func calculateSnapshotCoverage(coverage *snapshotCoverage, stores []string) {
+	var (
+		covered          = make(map[int]bool)
+		memberPartitions = make(map[int]map[int]bool)
+	)
+
+	for _, store := range stores {
+		matches := storeGUIDPattern.FindStringSubmatch(store)
+		if matches == nil {
+			continue
+		}
+		partition, _ := strconv.Atoi(matches[1])
+		memberID, _ := strconv.Atoi(matches[4])
+		if partition >= coverage.PartitionCount {
+			continue
+		}
+
+		coverage.Stores++
+		covered[partition] = true
+		if _, ok := memberPartitions[memberID]; !ok {
+			memberPartitions[memberID] = make(map[int]bool)
+		}
+		memberPartitions[memberID][partition] = true
+	}
+
+	for partition := 0; partition < coverage.PartitionCount; partition++ {
+		if !covered[partition] {
+			coverage.MissingPartitions = append(coverage.MissingPartitions, partition)
+		}
+	}
+
+	for memberID, partitions := range memberPartitions {
+		coverage.Members = append(coverage.Members, snapshotMemberCoverage{MemberID: memberID, Partitions: len(partitions)})
+	}
+	sort.Slice(coverage.Members, func(p, q int) bool {
+		return coverage.Members[p].MemberID < coverage.Members[q].MemberID
+	})
+
+	coverage.CoveredPartitions = len(covered)
+	coverage.Complete = coverage.Exists && coverage.StoresFound && len(coverage.MissingPartitions) == 0
+}
+
+// getSnapshotDirectories returns the snapshot directories specified.
+func getSnapshotDirectories() []string {
+	directories := make([]string, 0)
+	if snapshotDirectoriesParam == "" {
+		return directories
+	}
+	for _, v := range strings.Split(snapshotDirectoriesParam, ",") {
+		if directory := strings.TrimSpace(v); directory != "" {
+			directories = append(directories, directory)
+		}
+	}
+	return directories
+}
+
+func init() {
+	checkSnapshotCmd.Flags().StringVarP(&serviceName, serviceNameOption, serviceNameOptionShort, "", serviceNameDescription)
+	_ = checkSnapshotCmd.MarkFlagRequired(serviceNameOption)
+	checkSnapshotCmd.Flags().BoolVarP(&ArchivedSnapshots, "archived", "a", false, "if true, checks an archived snapshot, otherwise a local snapshot")
+	checkSnapshotCmd.Flags().StringVarP(&snapshotDirectoriesParam, "snapshot-dirs", "D", "", "comma separated list of snapshot directories to scan for a local snapshot")
+
+	recoverSnapshotCmd.Flags().BoolVarP(&forceRecoverParam, "force", "", false, "recover the snapshot even if partitions are missing or cannot be checked")
+	recoverSnapshotCmd.Flags().StringVarP(&snapshotDirectoriesParam, "snapshot-dirs", "D", "", "comma separated list of snapshot directories to check before recovering")
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSnapshotCoverage(t *testing.T) {
+	var (
+		g        = gomega.NewGomegaWithT(t)
+		coverage = snapshotCoverage{Exists: true, StoresFound: true, PartitionCount: 7}
+		stores   = []string{"0-1-18f2a3b4c5d-1", "1-1-18f2a3b4c5d-1", "2-1-18f2a3b4c5d-1",
+			"5-1-18f2a3b4c5e-3", "6-1-18f2a3b4c5e-3", "6-2-18f2a3b4c5e-3", "not-a-store"}
+	)
+
+	// the stores of member 2, partitions 3 and 4, are missing
+	calculateSnapshotCoverage(&coverage, stores)
+	g.Expect(coverage.Stores).To(gomega.Equal(6))
+	g.Expect(coverage.CoveredPartitions).To(gomega.Equal(5))
+	g.Expect(coverage.MissingPartitions).To(gomega.Equal([]int{3, 4}))
+	g.Expect(coverage.Members).To(gomega.Equal([]snapshotMemberCoverage{{MemberID: 1, Partitions: 3}, {MemberID: 3, Partitions: 2}}))
+	g.Expect(coverage.Complete).To(gomega.BeFalse())
+
+	coverage = snapshotCoverage{Exists: true, StoresFound: true, PartitionCount: 7}
+	calculateSnapshotCoverage(&coverage, append(stores, "3-1-18f2a3b4c5f-2", "4-1-18f2a3b4c5f-2"))
+	g.Expect(coverage.MissingPartitions).To(gomega.BeEmpty())
+	g.Expect(coverage.Complete).To(gomega.BeTrue())
+}
+
+func TestSnapshotDirectoryStores(t *testing.T) {
+	var (
+		g       = gomega.NewGomegaWithT(t)
+		baseDir = t.TempDir()
+	)
+
+	for member, snapshot := range []string{"snapshot-1", "snapshot-1", "snapshot-2"} {
+		dir := filepath.Join(baseDir, fmt.Sprintf("member-%d", member+1), "cluster", "PartitionedCache", snapshot,
+			fmt.Sprintf("%d-1-18f2a3b4c5d-%d", member, member+1))
+		g.Expect(os.MkdirAll(dir, 0755)).To(gomega.Succeed())
+	}
+
+	stores, err := getSnapshotDirectoryStores([]string{baseDir}, "snapshot-1")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(stores).To(gomega.ConsistOf("0-1-18f2a3b4c5d-1", "1-1-18f2a3b4c5d-2"))
+
+	_, err = getSnapshotDirectoryStores([]string{filepath.Join(baseDir, "missing")}, "snapshot-1")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestRecoverCoverageMessage(t *testing.T) {
+	var (
+		g        = gomega.NewGomegaWithT(t)
+		coverage = snapshotCoverage{SnapshotName: "snapshot-1", Exists: true, PartitionCount: 7}
+	)
+
+	// the coverage cannot be verified without the snapshot directories
+	message, err := getRecoverCoverageMessage(coverage, false)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(message).To(gomega.HavePrefix("Warning: unable to verify"))
+
+	coverage.StoresFound = true
+	coverage.MissingPartitions = []int{3, 4}
+	_, err = getRecoverCoverageMessage(coverage, false)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("missing 2 of 7 partitions")))
+	message, err = getRecoverCoverageMessage(coverage, true)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(message).To(gomega.HavePrefix("Warning: snapshot snapshot-1 is missing"))
+
+	coverage.Complete = true
+	coverage.MissingPartitions = []int{}
+	message, err = getRecoverCoverageMessage(coverage, false)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(message).To(gomega.Equal("Snapshot snapshot-1 covers all 7 partitions"))
+
+	// a snapshot which does not exist is reported by the recover operation
+	message, err = getRecoverCoverageMessage(snapshotCoverage{}, false)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(message).To(gomega.BeEmpty())
+}
@@ -0,0 +1,482 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	snapshotTimeFormat      = "20060102-150405"
+	retentionLast           = "last"
+	retentionHourly         = "hourly"
+	retentionDaily          = "daily"
+	retentionWeekly         = "weekly"
+	retentionMonthly        = "monthly"
+	persistencePollInterval = 2
+)
+
+var (
+	cronParam               string
+	retentionParam          string
+	snapshotPrefixParam     string
+	archiveScheduledParam   bool
+	applyRetentionParam     bool
+	persistenceTimeoutParam int32
+
+	retentionPattern1 = regexp.MustCompile(`(?i)^(?:keep\s+)?(?:last\s+)?(\d+)\s+(last|hourly|daily|weekly|monthly)$`)
+	retentionPattern2 = regexp.MustCompile(`(?i)^(last|hourly|daily|weekly|monthly)\s*=\s*(\d+)$`)
+
+	cronMacros = map[string]string{
+		"@hourly":  "0 * * * *",
+		"@daily":   "0 0 * * *",
+		"@weekly":  "0 0 * * 0",
+		"@monthly": "0 0 1 * *",
+	}
+
+	// retentionPeriods returns the period a snapshot time belongs to for each retention type
+	retentionPeriods = map[string]func(time.Time) string{
+		retentionHourly:  func(t time.Time) string { return t.Format("2006010215") },
+		retentionDaily:   func(t time.Time) string { return t.Format("20060102") },
+		retentionMonthly: func(t time.Time) string { return t.Format("200601") },
+		retentionWeekly: func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-%02d", year, week)
+		},
+	}
+)
+
+// cronSchedule is a parsed cron schedule of minute, hour, day of month, month and day of week.
+type cronSchedule struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+	anyDay      bool
+	anyWeekday  bool
+}
+
+// snapshotRetention is the number of snapshots to keep for each retention type.
+type snapshotRetention map[string]int
+
+// scheduledSnapshot is a snapshot created by a schedule with the time it was created.
+type scheduledSnapshot struct {
+	name    string
+	created time.Time
+}
+
+// scheduleSnapshotCmd represents the schedule snapshot command.
+var scheduleSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "create snapshots for a service on a schedule and apply a retention policy",
+	Long: `The 'schedule snapshot' command creates snapshots for a service using a cron schedule, such as
+'0 2 * * *' or '@daily', running in the foreground until interrupted. Snapshot names are generated
+from the prefix and the current time. If '-A' is specified, each snapshot is archived and the local
+snapshot removed. After each snapshot the retention policy is applied to the snapshots created by
+the schedule, or the archived snapshots if '-A' is specified, for example 'keep last 7 daily, 4 weekly'
+or 'daily=7,weekly=4'. Valid retention types are last, hourly, daily, weekly and monthly. Use
+'--apply-retention' to create a single snapshot and apply the retention policy without confirmation,
+for use from cron.`,
+	Args: cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		var (
+			schedule  cronSchedule
+			retention snapshotRetention
+			err       error
+		)
+
+		if cronParam == "" && !applyRetentionParam {
+			return errors.New("you must specify a schedule using --cron or use --apply-retention")
+		}
+
+		if cronParam != "" {
+			if schedule, err = parseCronSchedule(cronParam); err != nil {
+				return err
+			}
+		}
+
+		if retentionParam != "" {
+			if retention, err = parseSnapshotRetention(retentionParam); err != nil {
+				return err
+			}
+		}
+
+		prefix := utils.SanitizeSnapshotName(snapshotPrefixParam)
+		if prefix == "" {
+			return errors.New("you must specify a snapshot prefix")
+		}
+
+		_, dataFetcher, err := GetConnectionAndDataFetcher()
+		if err != nil {
+			return err
+		}
+
+		if applyRetentionParam {
+			// this is run from cron where there is no terminal to confirm each of the operations
+			automaticallyConfirm = true
+			return runScheduledSnapshot(cmd, dataFetcher, prefix, retention, time.Now())
+		}
+
+		if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to schedule snapshots for service %s using '%s'? (y/n) ",
+			serviceName, cronParam)) {
+			return nil
+		}
+
+		// confirmation of each of the operations is not required
+		automaticallyConfirm = true
+
+		for {
+			next := schedule.next(time.Now())
+			cmd.Printf("Next snapshot for service %s at %v\n", serviceName, next.Format(time.DateTime))
+			time.Sleep(time.Until(next))
+
+			// errors are displayed and the schedule continues
+			if err = runScheduledSnapshot(cmd, dataFetcher, prefix, retention, next); err != nil {
+				cmd.Printf("Error: %v\n", err)
+			}
+		}
+	},
+}
+
+// runScheduledSnapshot creates and optionally archives a snapshot and then applies the retention policy.
+func runScheduledSnapshot(cmd *cobra.Command, dataFetcher fetcher.Fetcher, prefix string, retention snapshotRetention, now time.Time) error {
+	var snapshotName = getScheduledSnapshotName(prefix, now)
+
+	ArchivedSnapshots = false
+	if err := invokePersistenceOperation(cmd, fetcher.CreateSnapshot, snapshotName, false); err != nil {
+		return err
+	}
+	if err := waitForSnapshotOperation(cmd, dataFetcher, serviceName, snapshotName, false, true); err != nil {
+		return err
+	}
+
+	if archiveScheduledParam {
+		if err := invokePersistenceOperation(cmd, fetcher.ArchiveSnapshot, snapshotName, true); err != nil {
+			return err
+		}
+		if err := waitForSnapshotOperation(cmd, dataFetcher, serviceName, snapshotName, true, true); err != nil {
+			return err
+		}
+		if err := invokePersistenceOperation(cmd, fetcher.RemoveSnapshot, snapshotName, true); err != nil {
+			return err
+		}
+		if err := waitForSnapshotOperation(cmd, dataFetcher, serviceName, snapshotName, false, false); err != nil {
+			return err
+		}
+	}
+
+	if len(retention) == 0 {
+		return nil
+	}
+
+	return applySnapshotRetention(cmd, dataFetcher, prefix, retention)
+}
+
+// applySnapshotRetention removes the local or archived snapshots created by the schedule
+// that are not kept by the retention policy.
+func applySnapshotRetention(cmd *cobra.Command, dataFetcher fetcher.Fetcher, prefix string, retention snapshotRetention) error {
+	var (
+		snapshots []string
+		err       error
+	)
+
+	if archiveScheduledParam {
+		snapshots, err = GetArchivedSnapshots(dataFetcher, serviceName)
+	} else {
+		snapshots, err = GetSnapshots(dataFetcher, serviceName)
+	}
+	if err != nil {
+		return err
+	}
+
+	toRemove := getSnapshotsToRemove(snapshots, prefix, retention)
+	cmd.Printf("Retention policy keeps %d snapshot(s) and removes %d\n", len(getScheduledSnapshots(snapshots, prefix))-len(toRemove), len(toRemove))
+
+	ArchivedSnapshots = archiveScheduledParam
+	defer func() { ArchivedSnapshots = false }()
+
+	for _, snapshot := range toRemove {
+		if err = invokePersistenceOperation(cmd, fetcher.RemoveSnapshot, snapshot, true); err != nil {
+			return err
+		}
+		if err = waitForSnapshotOperation(cmd, dataFetcher, serviceName, snapshot, archiveScheduledParam, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitForSnapshotOperation waits for a persistence operation on a snapshot to complete. The coordinator
+// may still be idle before an asynchronous operation has started, so the operation is only complete
+// once the coordinator is idle and the local or archived snapshot exists, or no longer exists, as expected.
+func waitForSnapshotOperation(cmd *cobra.Command, dataFetcher fetcher.Fetcher, service, snapshot string, archived, exists bool) error {
+	var startTime = time.Now()
+
+	for {
+		var coordinator = config.PersistenceCoordinator{}
+
+		data, err := dataFetcher.GetPersistenceCoordinator(service)
+		if err != nil {
+			return err
+		}
+		if err = json.Unmarshal(data, &coordinator); err != nil {
+			return err
+		}
+
+		if coordinator.Idle {
+			var snapshots = coordinator.Snapshots
+			if archived {
+				if snapshots, err = GetArchivedSnapshots(dataFetcher, service); err != nil {
+					return err
+				}
+			}
+			if utils.SliceContains(snapshots, snapshot) == exists {
+				return nil
+			}
+		}
+
+		if int32(time.Since(startTime).Seconds()) > persistenceTimeoutParam {
+			return fmt.Errorf("persistence operation for snapshot %s on service %s did not complete within %d seconds, status is %s",
+				snapshot, service, persistenceTimeoutParam, coordinator.OperationStatus)
+		}
+
+		cmd.Printf("Waiting for persistence operation to complete for service %s: %s\n", service, coordinator.OperationStatus)
+		time.Sleep(time.Duration(persistencePollInterval) * time.Second)
+	}
+}
+
+// getScheduledSnapshotName returns the name of a snapshot created by a schedule at the given time.
+func getScheduledSnapshotName(prefix string, created time.Time) string {
+	return utils.SanitizeSnapshotName(prefix + "-" + created.Format(snapshotTimeFormat))
+}
+
+// getScheduledSnapshots returns the snapshots created by a schedule, newest first. Snapshots with
+// names that do not match the prefix and time format are ignored.
+func getScheduledSnapshots(snapshots []string, prefix string) []scheduledSnapshot {
+	result := make([]scheduledSnapshot, 0)
+
+	for _, name := range snapshots {
+		value, ok := strings.CutPrefix(name, prefix+"-")
+		if !ok {
+			continue
+		}
+		if created, err := time.ParseInLocation(snapshotTimeFormat, value, time.Local); err == nil {
+			result = append(result, scheduledSnapshot{name: name, created: created})
+		}
+	}
+
+	sort.Slice(result, func(p, q int) bool {
+		return result[p].created.After(result[q].created)
+	})
+
+	return result
+}
+
+// getSnapshotsToRemove returns the snapshots created by a schedule that are not kept by the retention
+// policy, oldest first. For each retention type the newest snapshot in each of the most recent periods
+// is kept, and a snapshot is removed only if it is not kept by any retention type.
+func getSnapshotsToRemove(snapshots []string, prefix string, retention snapshotRetention) []string {
+	var (
+		scheduled = getScheduledSnapshots(snapshots, prefix)
+		keep      = make(map[string]bool)
+		result    = make([]string, 0)
+	)
+
+	for i := 0; i < len(scheduled) && i < retention[retentionLast]; i++ {
+		keep[scheduled[i].name] = true
+	}
+
+	for retentionType, period := range retentionPeriods {
+		periods := make(map[string]bool)
+		for _, snapshot := range scheduled {
+			key := period(snapshot.created)
+			if periods[key] {
+				continue
+			}
+			if len(periods) == retention[retentionType] {
+				break
+			}
+			periods[key] = true
+			keep[snapshot.name] = true
+		}
+	}
+
+	for i := len(scheduled) - 1; i >= 0; i-- {
+		if !keep[scheduled[i].name] {
+			result = append(result, scheduled[i].name)
+		}
+	}
+
+	return result
+}
+
+// parseSnapshotRetention parses a retention policy such as "keep last 7 daily, 4 weekly" or "daily=7,weekly=4".
+func parseSnapshotRetention(value string) (snapshotRetention, error) {
+	var retention = make(snapshotRetention)
+
+	for _, v := range strings.Split(value, ",") {
+		var (
+			item          = strings.TrimSpace(v)
+			retentionType string
+			count         string
+		)
+
+		if matches := retentionPattern1.FindStringSubmatch(item); matches != nil {
+			count, retentionType = matches[1], matches[2]
+		} else if matches = retentionPattern2.FindStringSubmatch(item); matches != nil {
+			retentionType, count = matches[1], matches[2]
+		} else {
+			return nil, fmt.Errorf("invalid retention %s, must be a count followed by one of last, hourly, daily, weekly or monthly", item)
+		}
+
+		retention[strings.ToLower(retentionType)], _ = strconv.Atoi(count)
+	}
+
+	total := 0
+	for _, count := range retention {
+		total += count
+	}
+	if total == 0 {
+		return nil, errors.New("the retention policy must keep at least one snapshot")
+	}
+
+	return retention, nil
+}
+
+// parseCronSchedule parses a cron schedule of minute, hour, day of month, month and day of week,
+// or one of the macros @hourly, @daily, @weekly or @monthly.
+func parseCronSchedule(value string) (cronSchedule, error) {
+	var (
+		schedule cronSchedule
+		err      error
+	)
+
+	if macro, ok := cronMacros[strings.ToLower(strings.TrimSpace(value))]; ok {
+		value = macro
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) != 5 {
+		return schedule, fmt.Errorf("invalid schedule %s, must have 5 fields of minute, hour, day of month, month and day of week", value)
+	}
+
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59); err != nil {
+		return schedule, err
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23); err != nil {
+		return schedule, err
+	}
+	if schedule.daysOfMonth, err = parseCronField(fields[2], 1, 31); err != nil {
+		return schedule, err
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12); err != nil {
+		return schedule, err
+	}
+	// 0 and 7 are both Sunday
+	if schedule.daysOfWeek, err = parseCronField(fields[4], 0, 7); err != nil {
+		return schedule, err
+	}
+	if schedule.daysOfWeek[7] {
+		schedule.daysOfWeek[0] = true
+	}
+
+	schedule.anyDay = fields[2] == "*"
+	schedule.anyWeekday = fields[4] == "*"
+
+	return schedule, nil
+}
+
+// parseCronField parses a cron field containing a comma separated list of values, ranges and steps.
+func parseCronField(field string, minValue, maxValue int) (map[int]bool, error) {
+	var (
+		values  = make(map[int]bool)
+		invalid = fmt.Errorf("invalid schedule field %s, values must be between %d and %d", field, minValue, maxValue)
+	)
+
+	for _, part := range strings.Split(field, ",") {
+		var (
+			start, end = minValue, maxValue
+			step       = 1
+			err        error
+		)
+
+		rangeValue, stepValue, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if step, err = strconv.Atoi(stepValue); err != nil || step < 1 {
+				return nil, invalid
+			}
+		}
+
+		if rangeValue != "*" {
+			startValue, endValue, isRange := strings.Cut(rangeValue, "-")
+			if start, err = strconv.Atoi(startValue); err != nil {
+				return nil, invalid
+			}
+			end = start
+			if isRange {
+				if end, err = strconv.Atoi(endValue); err != nil {
+					return nil, invalid
+				}
+			} else if hasStep {
+				end = maxValue
+			}
+		}
+
+		if start < minValue || end > maxValue || start > end {
+			return nil, invalid
+		}
+
+		for i := start; i <= end; i += step {
+			values[i] = true
+		}
+	}
+
+	return values, nil
+}
+
+// next returns the next time after the given time that matches the schedule. As with cron, if both
+// the day of month and day of week are restricted, a day matching either is used.
+func (s cronSchedule) next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+
+	// a schedule matches at least once every 4 years
+	for limit := t.AddDate(5, 0, 0); t.Before(limit); t = t.Add(time.Minute) {
+		if !s.months[int(t.Month())] || !s.hours[t.Hour()] || !s.minutes[t.Minute()] {
+			continue
+		}
+
+		dayMatch, weekdayMatch := s.daysOfMonth[t.Day()], s.daysOfWeek[int(t.Weekday())]
+		if (s.anyDay || s.anyWeekday) && dayMatch && weekdayMatch || !s.anyDay && !s.anyWeekday && (dayMatch || weekdayMatch) {
+			return t
+		}
+	}
+
+	return t
+}
+
+func init() {
+	setPersistenceFlags(scheduleSnapshotCmd)
+	scheduleSnapshotCmd.Flags().StringVarP(&cronParam, "cron", "C", "", "cron schedule of minute, hour, day of month, month and day of week, or @hourly, @daily, @weekly or @monthly")
+	scheduleSnapshotCmd.Flags().StringVarP(&retentionParam, "retention", "R", "", "retention policy, e.g. 'keep last 7 daily, 4 weekly'")
+	scheduleSnapshotCmd.Flags().StringVarP(&snapshotPrefixParam, "prefix", "p", "scheduled", "prefix for snapshot names")
+	scheduleSnapshotCmd.Flags().BoolVarP(&archiveScheduledParam, "archive", "A", false, "archive each snapshot and remove the local snapshot")
+	scheduleSnapshotCmd.Flags().BoolVarP(&applyRetentionParam, "apply-retention", "", false, "create a single snapshot, apply the retention policy and exit")
+	scheduleSnapshotCmd.Flags().Int32VarP(&persistenceTimeoutParam, "timeout", "T", 600, "timeout in seconds to wait for each persistence operation to complete")
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"testing"
+	"time"
+)
+
+func TestCronSchedule(t *testing.T) {
+	var (
+		g     = gomega.NewGomegaWithT(t)
+		start = time.Date(2026, 3, 4, 10, 30, 15, 0, time.Local) // a Wednesday
+	)
+
+	schedule, err := parseCronSchedule("0 2 * * *")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(schedule.next(start)).To(gomega.Equal(time.Date(2026, 3, 5, 2, 0, 0, 0, time.Local)))
+
+	schedule, err = parseCronSchedule("*/15 9-17 * * 1-5")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(schedule.next(start)).To(gomega.Equal(time.Date(2026, 3, 4, 10, 45, 0, 0, time.Local)))
+
+	// Sunday
+	schedule, err = parseCronSchedule("@weekly")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(schedule.next(start)).To(gomega.Equal(time.Date(2026, 3, 8, 0, 0, 0, 0, time.Local)))
+
+	// either the day of month or day of week matches when both are restricted
+	schedule, err = parseCronSchedule("0 0 1 * 5")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(schedule.next(start)).To(gomega.Equal(time.Date(2026, 3, 6, 0, 0, 0, 0, time.Local)))
+
+	_, err = parseCronSchedule("0 25 * * *")
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = parseCronSchedule("0 2 * *")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestSnapshotRetention(t *testing.T) {
+	var (
+		g         = gomega.NewGomegaWithT(t)
+		start     = time.Date(2026, 1, 1, 2, 0, 0, 0, time.Local)
+		snapshots = []string{"manual-snapshot", "other-20260101-020000"}
+	)
+
+	retention, err := parseSnapshotRetention("keep last 7 daily, 4 weekly")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(retention).To(gomega.Equal(snapshotRetention{retentionDaily: 7, retentionWeekly: 4}))
+
+	retention, err = parseSnapshotRetention("daily=7,weekly=4, 2 last")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(retention[retentionLast]).To(gomega.Equal(2))
+
+	_, err = parseSnapshotRetention("7 yearly")
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = parseSnapshotRetention("daily=0")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	// 60 daily snapshots, from Thursday 1 January to Monday 1 March
+	for i := 0; i < 60; i++ {
+		snapshots = append(snapshots, getScheduledSnapshotName("scheduled", start.AddDate(0, 0, i)))
+	}
+	g.Expect(getScheduledSnapshotName("scheduled", start)).To(gomega.Equal("scheduled-20260101-020000"))
+
+	toRemove := getSnapshotsToRemove(snapshots, "scheduled", snapshotRetention{retentionDaily: 7, retentionWeekly: 4})
+
+	// the last 7 days are kept, plus the Sundays of the 3 weeks before
+	g.Expect(len(toRemove)).To(gomega.Equal(50))
+	g.Expect(toRemove[0]).To(gomega.Equal("scheduled-20260101-020000"))
+	g.Expect(toRemove).To(gomega.Not(gomega.ContainElement("scheduled-20260222-020000")))
+	g.Expect(toRemove).To(gomega.ContainElement("scheduled-20260221-020000"))
+	g.Expect(toRemove).To(gomega.Not(gomega.ContainElement("manual-snapshot")))
+	g.Expect(toRemove).To(gomega.Not(gomega.ContainElement("other-20260101-020000")))
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var allServicesParam bool
+
+// createServicesSnapshot creates a snapshot with the same name for a group of persistence services.
+func createServicesSnapshot(cmd *cobra.Command, snapshotName string) error {
+	if snapshotName == "" {
+		return errors.New(provideSnapshot)
+	}
+	snapshotName = utils.SanitizeSnapshotName(snapshotName)
+
+	connection, dataFetcher, err := GetConnectionAndDataFetcher()
+	if err != nil {
+		return err
+	}
+
+	persistenceServices, err := GetPersistenceServices(dataFetcher)
+	if err != nil {
+		return err
+	}
+
+	services, err := getSnapshotServices(serviceName, persistenceServices, allServicesParam)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		snapshots, err := GetSnapshots(dataFetcher, service)
+		if err != nil {
+			return err
+		}
+		if utils.SliceContains(snapshots, snapshotName) {
+			return fmt.Errorf("a snapshot named %s already exists for service %s", snapshotName, service)
+		}
+	}
+
+	cmd.Println(FormatCurrentCluster(connection))
+
+	if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to suspend services %v and create snapshot %s for each? (y/n) ",
+		services, snapshotName)) {
+		return nil
+	}
+
+	return runServicesSnapshot(cmd, dataFetcher, services, snapshotName)
+}
+
+// runServicesSnapshot suspends the services so that the snapshots are consistent, and creates and
+// verifies the snapshot for each service in turn. If any snapshot fails, the snapshots already
+// created are removed. The services that were suspended are always resumed, and any services
+// that were already suspended are left suspended.
+func runServicesSnapshot(cmd *cobra.Command, dataFetcher fetcher.Fetcher, services []string, snapshotName string) (err error) {
+	var (
+		created   = make([]string, 0)
+		suspended = make([]string, 0)
+	)
+
+	alreadySuspended, err := getSuspendedServices(dataFetcher, services)
+	if err != nil {
+		return err
+	}
+	if len(alreadySuspended) > 0 {
+		cmd.Printf("Services %v are already suspended and will not be resumed\n", alreadySuspended)
+	}
+
+	// resume the services that were suspended regardless of the result
+	defer func() {
+		for _, service := range suspended {
+			if _, resumeErr := dataFetcher.InvokeServiceOperation(service, fetcher.ResumeService); resumeErr != nil {
+				err = errors.Join(err, utils.GetError("unable to resume service "+service, resumeErr))
+				continue
+			}
+			cmd.Printf("Resumed service %s\n", service)
+		}
+	}()
+
+	for _, service := range services {
+		if utils.SliceContains(alreadySuspended, service) {
+			continue
+		}
+		if _, err = dataFetcher.InvokeServiceOperation(service, fetcher.SuspendService); err != nil {
+			return utils.GetError("unable to suspend service "+service, err)
+		}
+		suspended = append(suspended, service)
+		cmd.Printf("Suspended service %s\n", service)
+	}
+
+	for _, service := range services {
+		// the snapshot may have been created even if the operation returns an error
+		created = append(created, service)
+		if err = createAndVerifyServiceSnapshot(cmd, dataFetcher, service, snapshotName); err != nil {
+			return rollbackServicesSnapshot(cmd, dataFetcher, snapshotName, created, err)
+		}
+	}
+
+	cmd.Printf("Snapshot %s created for services %v\n", snapshotName, services)
+
+	return nil
+}
+
+// getSuspendedServices returns the services which are currently suspended.
+func getSuspendedServices(dataFetcher fetcher.Fetcher, services []string) ([]string, error) {
+	var suspended = make([]string, 0)
+
+	servicesSummary, err := GetServices(dataFetcher)
+	if err != nil {
+		return nil, utils.GetError("unable to retrieve the state of the services", err)
+	}
+
+	for _, value := range servicesSummary.Services {
+		if value.QuorumStatus == "Suspended" && utils.SliceContains(services, value.ServiceName) &&
+			!utils.SliceContains(suspended, value.ServiceName) {
+			suspended = append(suspended, value.ServiceName)
+		}
+	}
+
+	return suspended, nil
+}
+
+// createAndVerifyServiceSnapshot creates a snapshot for a service and waits for the
+// persistence operation to complete and the snapshot to exist.
+func createAndVerifyServiceSnapshot(cmd *cobra.Command, dataFetcher fetcher.Fetcher, service, snapshotName string) error {
+	if _, err := dataFetcher.InvokeSnapshotOperation(service, snapshotName, fetcher.CreateSnapshot, false); err != nil {
+		return utils.GetError(fmt.Sprintf("unable to create snapshot %s for service %s", snapshotName, service), err)
+	}
+
+	if err := waitForSnapshotOperation(cmd, dataFetcher, service, snapshotName, false, true); err != nil {
+		return err
+	}
+
+	cmd.Printf("Verified snapshot %s for service %s\n", snapshotName, service)
+	return nil
+}
+
+// rollbackServicesSnapshot removes the snapshot from the services it may have been created for and
+// returns the original error along with any errors from removing the snapshots.
+func rollbackServicesSnapshot(cmd *cobra.Command, dataFetcher fetcher.Fetcher, snapshotName string, services []string, cause error) error {
+	var errorList = []error{cause}
+
+	cmd.Printf("Removing snapshot %s from services %v\n", snapshotName, services)
+
+	for _, service := range services {
+		snapshots, err := GetSnapshots(dataFetcher, service)
+		if err != nil {
+			errorList = append(errorList, err)
+			continue
+		}
+		if !utils.SliceContains(snapshots, snapshotName) {
+			continue
+		}
+
+		if _, err = dataFetcher.InvokeSnapshotOperation(service, snapshotName, fetcher.RemoveSnapshot, false); err == nil {
+			err = waitForSnapshotOperation(cmd, dataFetcher, service, snapshotName, false, false)
+		}
+		if err != nil {
+			errorList = append(errorList, utils.GetError(fmt.Sprintf("unable to remove snapshot %s from service %s", snapshotName, service), err))
+		}
+	}
+
+	return errors.Join(errorList...)
+}
+
+// getSnapshotServices returns the services to snapshot from a comma separated list of services
+// or all persistence services.
+func getSnapshotServices(serviceList string, persistenceServices []string, allServices bool) ([]string, error) {
+	var services = make([]string, 0)
+
+	if allServices {
+		if serviceList != "" {
+			return nil, errors.New("you cannot specify a service name and all services")
+		}
+		if len(persistenceServices) == 0 {
+			return nil, errors.New("there are no persistence services")
+		}
+		return persistenceServices, nil
+	}
+
+	for _, v := range strings.Split(serviceList, ",") {
+		service := strings.TrimSpace(v)
+		if service == "" || utils.SliceContains(services, service) {
+			continue
+		}
+		if !utils.SliceContains(persistenceServices, service) {
+			return nil, fmt.Errorf("cannot find persistence service named %s", service)
+		}
+		services = append(services, service)
+	}
+
+	if len(services) == 0 {
+		return nil, errors.New(provideService)
+	}
+
+	return services, nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/config"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/spf13/cobra"
+	"slices"
+	"testing"
+)
+
+// snapshotStubFetcher is a Fetcher which records the persistence operations invoked and keeps the
+// snapshots for each service. Only the methods used to create snapshots are implemented.
+type snapshotStubFetcher struct {
+	fetcher.Fetcher
+	snapshots  map[string][]string
+	operations []string
+	failCreate string
+	suspended  []string
+}
+
+func (f *snapshotStubFetcher) GetServiceDetailsJSON() ([]byte, error) {
+	var summaries = config.ServicesSummaries{}
+	for _, service := range f.suspended {
+		summaries.Services = append(summaries.Services, config.ServiceSummary{ServiceName: service, QuorumStatus: "Suspended"})
+	}
+	return json.Marshal(summaries)
+}
+
+func (f *snapshotStubFetcher) GetPersistenceCoordinator(serviceName string) ([]byte, error) {
+	return json.Marshal(config.PersistenceCoordinator{Idle: true, OperationStatus: "Idle", Snapshots: f.snapshots[serviceName]})
+}
+
+func (f *snapshotStubFetcher) InvokeServiceOperation(serviceName, operation string) ([]byte, error) {
+	f.operations = append(f.operations, operation+" "+serviceName)
+	return nil, nil
+}
+
+func (f *snapshotStubFetcher) InvokeSnapshotOperation(serviceName, snapshotName, operation string, _ bool) ([]byte, error) {
+	f.operations = append(f.operations, operation+" "+serviceName)
+	switch {
+	case operation == fetcher.CreateSnapshot && serviceName == f.failCreate:
+		return nil, errors.New("create failed")
+	case operation == fetcher.CreateSnapshot:
+		f.snapshots[serviceName] = append(f.snapshots[serviceName], snapshotName)
+	case operation == fetcher.RemoveSnapshot:
+		f.snapshots[serviceName] = slices.DeleteFunc(f.snapshots[serviceName], func(s string) bool { return s == snapshotName })
+	}
+	return nil, nil
+}
+
+func TestGetSnapshotServices(t *testing.T) {
+	var (
+		g                   = gomega.NewGomegaWithT(t)
+		persistenceServices = []string{"PartitionedCache", "OrdersCache", "CustomersCache"}
+	)
+
+	services, err := getSnapshotServices("OrdersCache, CustomersCache,OrdersCache", persistenceServices, false)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(services).To(gomega.Equal([]string{"OrdersCache", "CustomersCache"}))
+
+	services, err = getSnapshotServices("", persistenceServices, true)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(services).To(gomega.Equal(persistenceServices))
+
+	_, err = getSnapshotServices("OrdersCache,Missing", persistenceServices, false)
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = getSnapshotServices(" , ", persistenceServices, false)
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = getSnapshotServices("OrdersCache", persistenceServices, true)
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = getSnapshotServices("", []string{}, true)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestRunServicesSnapshot(t *testing.T) {
+	var (
+		g        = gomega.NewGomegaWithT(t)
+		cmd      = &cobra.Command{}
+		services = []string{"OrdersCache", "CustomersCache"}
+		f        = &snapshotStubFetcher{snapshots: make(map[string][]string)}
+	)
+
+	cmd.SetOut(&bytes.Buffer{})
+	persistenceTimeoutParam = 10
+
+	// the services are suspended, each snapshot is created and verified, and the services resumed
+	g.Expect(runServicesSnapshot(cmd, f, services, "nightly")).To(gomega.Succeed())
+	g.Expect(f.operations).To(gomega.Equal([]string{
+		fetcher.SuspendService + " OrdersCache", fetcher.SuspendService + " CustomersCache",
+		fetcher.CreateSnapshot + " OrdersCache", fetcher.CreateSnapshot + " CustomersCache",
+		fetcher.ResumeService + " OrdersCache", fetcher.ResumeService + " CustomersCache"}))
+	g.Expect(f.snapshots["OrdersCache"]).To(gomega.Equal([]string{"nightly"}))
+	g.Expect(f.snapshots["CustomersCache"]).To(gomega.Equal([]string{"nightly"}))
+
+	// if a snapshot fails, the snapshots already created are removed and the services are still resumed
+	f = &snapshotStubFetcher{snapshots: make(map[string][]string), failCreate: "CustomersCache"}
+	err := runServicesSnapshot(cmd, f, services, "nightly")
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("create failed")))
+	g.Expect(f.operations).To(gomega.Equal([]string{
+		fetcher.SuspendService + " OrdersCache", fetcher.SuspendService + " CustomersCache",
+		fetcher.CreateSnapshot + " OrdersCache", fetcher.CreateSnapshot + " CustomersCache",
+		fetcher.RemoveSnapshot + " OrdersCache",
+		fetcher.ResumeService + " OrdersCache", fetcher.ResumeService + " CustomersCache"}))
+	g.Expect(f.snapshots["OrdersCache"]).To(gomega.BeEmpty())
+	g.Expect(f.snapshots["CustomersCache"]).To(gomega.BeEmpty())
+
+	// a service which is already suspended is not suspended or resumed
+	f = &snapshotStubFetcher{snapshots: make(map[string][]string), suspended: []string{"CustomersCache"}}
+	g.Expect(runServicesSnapshot(cmd, f, services, "nightly")).To(gomega.Succeed())
+	g.Expect(f.operations).To(gomega.Equal([]string{
+		fetcher.SuspendService + " OrdersCache",
+		fetcher.CreateSnapshot + " OrdersCache", fetcher.CreateSnapshot + " CustomersCache",
+		fetcher.ResumeService + " OrdersCache"}))
+}
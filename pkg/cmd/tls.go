@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/fetcher"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"path/filepath"
+)
+
+var (
+	tlsCACertParam     string
+	tlsClientCertParam string
+	tlsClientKeyParam  string
+	tlsMinVersionParam string
+	tlsServerNameParam string
+	clearTLSParam      bool
+)
+
+// setTLSCmd represents the set tls command.
+var setTLSCmd = &cobra.Command{
+	Use:   "tls connection-name",
+	Short: "set the TLS configuration for a cluster connection",
+	Long: `The 'set tls' command sets the TLS configuration for a cluster connection, overriding
+the COHERENCE_TLS_* environment variables. You can specify a CA certificate bundle, client
+certificate and key, minimum TLS version and server name to verify the certificate against.
+Only the options specified are changed. Use '--clear' to remove all TLS configuration.`,
+	ValidArgsFunction: completionAllClusters,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			displayErrorAndExit(cmd, youMustProviderConnectionMessage)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			connectionName = args[0]
+			index          = -1
+			err            error
+		)
+
+		for i, c := range Config.Clusters {
+			if c.Name == connectionName {
+				index = i
+				break
+			}
+		}
+
+		if index == -1 {
+			return errors.New(UnableToFindClusterMsg + connectionName)
+		}
+
+		connection := Config.Clusters[index]
+
+		if clearTLSParam {
+			connection.setTLSOptions(fetcher.TLSOptions{})
+		} else {
+			flags := cmd.Flags()
+			if !flags.Changed("tls-ca-cert") && !flags.Changed("tls-client-cert") && !flags.Changed("tls-client-key") &&
+				!flags.Changed("tls-min-version") && !flags.Changed("tls-server-name") {
+				return errors.New("you must specify at least one TLS option or --clear")
+			}
+			options := connection.getTLSOptions()
+			updated := getTLSOptionsFromFlags()
+			if flags.Changed("tls-ca-cert") {
+				options.CACertPath = updated.CACertPath
+			}
+			if flags.Changed("tls-client-cert") {
+				options.ClientCertPath = updated.ClientCertPath
+			}
+			if flags.Changed("tls-client-key") {
+				options.ClientKeyPath = updated.ClientKeyPath
+			}
+			if flags.Changed("tls-min-version") {
+				options.MinVersion = updated.MinVersion
+			}
+			if flags.Changed("tls-server-name") {
+				options.ServerName = updated.ServerName
+			}
+			connection.setTLSOptions(options)
+		}
+
+		// validate the files and version are valid before saving
+		if _, err = fetcher.NewTLSConfig(connection.getTLSOptions()); err != nil {
+			return err
+		}
+
+		if !confirmOperation(cmd, fmt.Sprintf("Are you sure you want to update the TLS configuration for connection %s? (y/n) ", connectionName)) {
+			return nil
+		}
+
+		Config.Clusters[index] = connection
+
+		viper.Set(clusterKey, Config.Clusters)
+		if err = WriteConfig(); err != nil {
+			return err
+		}
+
+		cmd.Printf("TLS configuration for connection %s was updated\n", connectionName)
+		return nil
+	},
+}
+
+// getTLSCmd represents the get tls command.
+var getTLSCmd = &cobra.Command{
+	Use:   "tls",
+	Short: "display the TLS configuration for cluster connections",
+	Long: `The 'get tls' command displays the TLS configuration for each cluster connection.
+Connections without TLS configuration use the COHERENCE_TLS_* environment variables.`,
+	Args: cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cmd.Println(FormatClustersTLS(Config.Clusters))
+		return nil
+	},
+}
+
+func init() {
+	addTLSFlags(setTLSCmd)
+	setTLSCmd.Flags().BoolVarP(&clearTLSParam, "clear", "", false, "clear all TLS configuration for the connection")
+	setTLSCmd.Flags().BoolVarP(&automaticallyConfirm, "yes", "y", false, confirmOptionMessage)
+}
+
+// addTLSFlags adds the flags to specify TLS configuration for a connection.
+func addTLSFlags(command *cobra.Command) {
+	command.Flags().StringVarP(&tlsCACertParam, "tls-ca-cert", "", "", "CA certificate bundle to trust")
+	command.Flags().StringVarP(&tlsClientCertParam, "tls-client-cert", "", "", "client certificate")
+	command.Flags().StringVarP(&tlsClientKeyParam, "tls-client-key", "", "", "client certificate key")
+	command.Flags().StringVarP(&tlsMinVersionParam, "tls-min-version", "", "", "minimum TLS version, 1.0, 1.1, 1.2 or 1.3")
+	command.Flags().StringVarP(&tlsServerNameParam, "tls-server-name", "", "", "server name to verify the certificate against")
+}
+
+// getTLSOptionsFromFlags returns the TLS options specified using the flags, with absolute paths.
+func getTLSOptionsFromFlags() fetcher.TLSOptions {
+	return fetcher.TLSOptions{
+		CACertPath:     getAbsolutePath(tlsCACertParam),
+		ClientCertPath: getAbsolutePath(tlsClientCertParam),
+		ClientKeyPath:  getAbsolutePath(tlsClientKeyParam),
+		MinVersion:     tlsMinVersionParam,
+		ServerName:     tlsServerNameParam,
+	}
+}
+
+// getAbsolutePath returns the absolute path so connections can be used from any directory.
+func getAbsolutePath(path string) string {
+	if path == "" {
+		return ""
+	}
+	if absolutePath, err := filepath.Abs(path); err == nil {
+		return absolutePath
+	}
+	return path
+}
+
+// getTLSOptions returns the TLS options for the connection.
+func (c ClusterConnection) getTLSOptions() fetcher.TLSOptions {
+	return fetcher.TLSOptions{
+		CACertPath:     c.TLSCACert,
+		ClientCertPath: c.TLSClientCert,
+		ClientKeyPath:  c.TLSClientKey,
+		MinVersion:     c.TLSMinVersion,
+		ServerName:     c.TLSServerName,
+	}
+}
+
+// setTLSOptions sets the TLS options for the connection.
+func (c *ClusterConnection) setTLSOptions(options fetcher.TLSOptions) {
+	c.TLSCACert = options.CACertPath
+	c.TLSClientCert = options.ClientCertPath
+	c.TLSClientKey = options.ClientKeyPath
+	c.TLSMinVersion = options.MinVersion
+	c.TLSServerName = options.ServerName
+}
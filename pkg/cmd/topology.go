@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	topologyArg     = "topology"
+	topologyMessage = "YAML topology file describing the member groups to start"
+)
+
+var (
+	topologyFileParam string
+	heapRegex         = regexp.MustCompile(`^\d+[kKmMgG]?$`)
+
+	// flags that cannot be specified with a topology file as they are set per member group
+	topologyExclusiveFlags = []string{"replicas", heapMemoryArg, profileArg, machineArg, rackArg, siteArg, roleArg,
+		cacheConfigArg, operationalConfigArg}
+)
+
+// ClusterTopology describes the member groups for a cluster created using a topology file.
+type ClusterTopology struct {
+	Groups []MemberGroup `yaml:"groups"`
+}
+
+// MemberGroup describes a group of identical members within a cluster topology.
+type MemberGroup struct {
+	Name              string   `yaml:"name"`
+	Replicas          int32    `yaml:"replicas"`
+	Role              string   `yaml:"role"`
+	Heap              string   `yaml:"heap"`
+	Profile           string   `yaml:"profile"`
+	JvmArgs           []string `yaml:"jvmArgs"`
+	Machine           string   `yaml:"machine"`
+	Rack              string   `yaml:"rack"`
+	Site              string   `yaml:"site"`
+	CacheConfig       string   `yaml:"cacheConfig"`
+	OperationalConfig string   `yaml:"operationalConfig"`
+	StorageEnabled    *bool    `yaml:"storageEnabled"` // defaults to true if not specified
+	Management        bool     `yaml:"management"`     // indicates the first member of this group runs management over REST
+}
+
+// topologyMember is a member to be started for a cluster along with the group it belongs to,
+// which is nil if the cluster was not created from a topology file.
+type topologyMember struct {
+	name  string
+	group *MemberGroup
+}
+
+// loadTopology loads and validates a topology file.
+func loadTopology(fileName string) ([]MemberGroup, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read topology file %s: %v", fileName, err)
+	}
+
+	return parseTopology(data, filepath.Dir(getAbsolutePath(fileName)))
+}
+
+// parseTopology parses and validates the YAML contents of a topology file. Relative
+// config file paths are resolved against baseDir, which is the directory of the file.
+func parseTopology(data []byte, baseDir string) ([]MemberGroup, error) {
+	var topology ClusterTopology
+
+	if err := yaml.Unmarshal(data, &topology); err != nil {
+		return nil, fmt.Errorf("unable to parse topology file: %v", err)
+	}
+
+	for i := range topology.Groups {
+		topology.Groups[i].CacheConfig = resolvePath(baseDir, topology.Groups[i].CacheConfig)
+		topology.Groups[i].OperationalConfig = resolvePath(baseDir, topology.Groups[i].OperationalConfig)
+	}
+
+	if err := validateTopology(topology.Groups); err != nil {
+		return nil, err
+	}
+
+	return topology.Groups, nil
+}
+
+// resolvePath returns the path relative to baseDir if it is not absolute.
+func resolvePath(baseDir, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
+
+// validateTopology validates the member groups in a topology.
+func validateTopology(groups []MemberGroup) error {
+	var (
+		names           = make(map[string]bool)
+		managementCount = 0
+	)
+
+	if len(groups) == 0 {
+		return errors.New("the topology must contain at least one member group")
+	}
+
+	for _, g := range groups {
+		if g.Name == "" {
+			return errors.New("each member group in the topology must have a name")
+		}
+		if !isValid(g.Name) {
+			return fmt.Errorf("member group name %s must only contain letters, numbers and '", g.Name)
+		}
+		if names[g.Name] {
+			return fmt.Errorf("member group name %s is duplicated in the topology", g.Name)
+		}
+		names[g.Name] = true
+
+		if g.Replicas < 1 {
+			return fmt.Errorf("replicas for member group %s must be 1 or more", g.Name)
+		}
+		if g.Heap != "" && !heapRegex.MatchString(g.Heap) {
+			return fmt.Errorf("invalid heap %s for member group %s", g.Heap, g.Name)
+		}
+		if g.Profile != "" && getProfileValue(g.Profile) == "" {
+			return fmt.Errorf("a profile with the name %s for member group %s does not exist", g.Profile, g.Name)
+		}
+		if g.CacheConfig != "" && !isRegularFile(g.CacheConfig) {
+			return fmt.Errorf("cache config file %s for member group %s does not exist", g.CacheConfig, g.Name)
+		}
+		if g.OperationalConfig != "" && !isRegularFile(g.OperationalConfig) {
+			return fmt.Errorf("operational override file %s for member group %s does not exist", g.OperationalConfig, g.Name)
+		}
+		if g.Management {
+			managementCount++
+		}
+	}
+
+	if managementCount > 1 {
+		return errors.New("only one member group in the topology can have management enabled")
+	}
+
+	return nil
+}
+
+// getTopologyMemberCount returns the total number of members in a topology.
+func getTopologyMemberCount(groups []MemberGroup) int32 {
+	var count int32
+	for _, g := range groups {
+		count += g.Replicas
+	}
+	return count
+}
+
+// getTopologyMembers returns the members to start for a topology, with the member
+// that runs management over REST first. If no group has management enabled, then
+// the first member of the first group is used.
+func getTopologyMembers(groups []MemberGroup) []topologyMember {
+	var (
+		members         = make([]topologyMember, 0)
+		managementGroup = 0
+	)
+
+	for i := range groups {
+		if groups[i].Management {
+			managementGroup = i
+			break
+		}
+	}
+
+	// add the management group first so the management port is allocated to its first member
+	order := []int{managementGroup}
+	for i := range groups {
+		if i != managementGroup {
+			order = append(order, i)
+		}
+	}
+
+	for _, i := range order {
+		group := &groups[i]
+		for j := int32(0); j < group.Replicas; j++ {
+			members = append(members, topologyMember{name: fmt.Sprintf("%s-%d", group.Name, j), group: group})
+		}
+	}
+
+	return members
+}
+
+// getMemberGroupArgs returns the arguments to start a member of a group with, which are
+// added after the common and cache server arguments so they override any cluster level values.
+func getMemberGroupArgs(group *MemberGroup) []string {
+	args := make([]string, 0)
+
+	if group.Profile != "" {
+		args = append(args, strings.Split(getProfileValue(group.Profile), " ")...)
+	}
+
+	args = append(args, group.JvmArgs...)
+
+	if group.CacheConfig != "" {
+		args = append(args, fmt.Sprintf("-Dcoherence.cacheconfig=%s", group.CacheConfig))
+	}
+	if group.OperationalConfig != "" {
+		args = append(args, fmt.Sprintf("-Dcoherence.override=%s", group.OperationalConfig))
+	}
+	if group.StorageEnabled != nil && !*group.StorageEnabled {
+		args = append(args, "-Dcoherence.distributed.localstorage=false")
+	}
+
+	return args
+}
+
+// getMemberGroupDescription returns a one line description of a member group.
+func getMemberGroupDescription(group MemberGroup) string {
+	var (
+		details = []string{fmt.Sprintf("replicas=%d", group.Replicas)}
+		storage = group.StorageEnabled == nil || *group.StorageEnabled
+	)
+
+	addDetail := func(name, value string) {
+		if value != "" {
+			details = append(details, fmt.Sprintf("%s=%s", name, value))
+		}
+	}
+
+	addDetail("role", group.Role)
+	addDetail("heap", group.Heap)
+	addDetail("profile", group.Profile)
+	addDetail("machine", group.Machine)
+	addDetail("rack", group.Rack)
+	addDetail("site", group.Site)
+	details = append(details, fmt.Sprintf("storage=%v", storage))
+	if group.Management {
+		details = append(details, "management=true")
+	}
+
+	return fmt.Sprintf("%s (%s)", group.Name, strings.Join(details, ", "))
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+const testTopology = `
+groups:
+  - name: proxy
+    replicas: 2
+    role: Proxy
+    storageEnabled: false
+    jvmArgs: ["-Dcoherence.proxy.enabled=true"]
+  - name: storage
+    replicas: 2
+    rack: rack1
+    heap: 512m
+    cacheConfig: cache-config.xml
+  - name: mgmt
+    replicas: 1
+    management: true
+    storageEnabled: false
+`
+
+func TestParseTopology(t *testing.T) {
+	var (
+		g   = gomega.NewGomegaWithT(t)
+		dir = t.TempDir()
+	)
+
+	g.Expect(os.WriteFile(filepath.Join(dir, "cache-config.xml"), []byte("<cache-config/>"), 0600)).To(gomega.Succeed())
+
+	groups, err := parseTopology([]byte(testTopology), dir)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(len(groups)).To(gomega.Equal(3))
+	g.Expect(getTopologyMemberCount(groups)).To(gomega.Equal(int32(5)))
+	g.Expect(groups[1].CacheConfig).To(gomega.Equal(filepath.Join(dir, "cache-config.xml")))
+
+	// the management group must be started first
+	members := getTopologyMembers(groups)
+	g.Expect(len(members)).To(gomega.Equal(5))
+	g.Expect(members[0].name).To(gomega.Equal("mgmt-0"))
+	g.Expect(members[1].name).To(gomega.Equal("proxy-0"))
+	g.Expect(members[4].name).To(gomega.Equal("storage-1"))
+
+	args := getMemberGroupArgs(&groups[0])
+	g.Expect(args).To(gomega.ContainElements("-Dcoherence.proxy.enabled=true", "-Dcoherence.distributed.localstorage=false"))
+
+	args = getCacheServerArgs(ClusterConnection{}, "storage-0", -1, "22.06.10", &groups[1])
+	g.Expect(args).To(gomega.ContainElements("-Dcoherence.rack=rack1", "-Xmx512m"))
+
+	// the group arguments must be after the cluster level arguments, so they override them, and before the main class
+	groups[1].JvmArgs = []string{"-Xmx1g", "-Dcoherence.role=Override"}
+	args = getCacheServerArgs(ClusterConnection{}, "storage-0", -1, "22.06.10", &groups[1])
+	g.Expect(slices.Index(args, "-Xmx1g")).To(gomega.BeNumerically(">", slices.Index(args, "-Xmx512m")))
+	g.Expect(slices.Index(args, "-Dcoherence.role=Override")).To(gomega.BeNumerically(">", slices.Index(args, "-Dcoherence.member=storage-0")))
+	g.Expect(slices.Index(args, "-Dcoherence.cacheconfig="+groups[1].CacheConfig)).To(gomega.Equal(len(args) - 2))
+	g.Expect(args[len(args)-1]).To(gomega.Equal(utils.GetCoherenceMainClass("22.06.10")))
+
+	// validation errors
+	_, err = parseTopology([]byte("groups: []"), dir)
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = parseTopology([]byte("groups:\n  - name: a\n    replicas: 0"), dir)
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = parseTopology([]byte("groups:\n  - name: a\n    replicas: 1\n  - name: a\n    replicas: 1"), dir)
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = parseTopology([]byte("groups:\n  - name: a\n    replicas: 1\n    cacheConfig: missing.xml"), dir)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,18 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// validateCmd represents the validate command.
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "validate a resource",
+	Long:  `The 'validate' command validates various resources.`,
+}
@@ -246,6 +246,9 @@ type ServiceStorageSummary struct {
 	FairShareBackup        int32  `json:"fairShareBackup"`
 	PartitionCount         int32  `json:"partitionCount"`
 	ServiceNodeCount       int32  `json:"serviceNodeCount"`
+
+	// RemainingDistributionCount is the number of partition transfers remaining to be completed
+	RemainingDistributionCount int32 `json:"remainingDistributionCount"`
 }
 
 // HealthSummaries contains and array of HealthSummary.
@@ -774,6 +777,11 @@ type Archives struct {
 	Snapshots []string `json:"archives"`
 }
 
+// ArchiveStores contains the stores for an archived snapshot.
+type ArchiveStores struct {
+	Stores []string `json:"archiveStores"`
+}
+
 // StatusValues contains JFR status result.
 type StatusValues struct {
 	Status []string `json:"status"`
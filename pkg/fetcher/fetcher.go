@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2021, 2025 Oracle and/or its affiliates.
+ * Copyright (c) 2021, 2026 Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * https://oss.oracle.com/licenses/upl.
  */
@@ -14,6 +14,7 @@ import (
 
 const (
 	HTTP                   = "http"
+	Replay                 = "replay"
 	CreateSnapshot         = "create snapshot"
 	RemoveSnapshot         = "remove snapshot"
 	RemoveArchivedSnapshot = "remove archived snapshot"
@@ -259,6 +260,9 @@ type Fetcher interface {
 	// GetArchivedSnapshots retrieves the list of archives snapshots.
 	GetArchivedSnapshots(serviceName string) ([]byte, error)
 
+	// GetArchivedSnapshotStores retrieves the list of stores for an archived snapshot.
+	GetArchivedSnapshotStores(serviceName, snapshotName string) ([]byte, error)
+
 	// GetHTTPSessionDetailsJSON returns Coherence*Web Http session details in raw json.
 	GetHTTPSessionDetailsJSON() ([]byte, error)
 
@@ -298,9 +302,45 @@ type Fetcher interface {
 
 // GetFetcherOrError returns a fetcher and error
 func GetFetcherOrError(connectionType, url, username, clusterName string) (Fetcher, error) {
+	return GetFetcherWithOptions(connectionType, url, username, clusterName, nil, TLSOptions{})
+}
+
+// ResolveAuth returns the fetcher with the credentials for requests resolved, prompting for a
+// username and password if required, so that requests do not update the global username and
+// password. This must be called before using a fetcher concurrently with other fetchers.
+func ResolveAuth(f Fetcher) (Fetcher, error) {
+	h, ok := f.(HTTPFetcher)
+	if !ok || h.Auth != nil {
+		return f, nil
+	}
+
+	auth, err := h.getRequestAuth()
+	if err != nil {
+		return nil, err
+	}
+	h.Auth = auth
+
+	return h, nil
+}
+
+// GetFetcherWithOptions returns a fetcher and error, using the credentials in auth if not nil
+// and the TLS options for the connection.
+func GetFetcherWithOptions(connectionType, url, username, clusterName string, auth *Auth, tlsOptions TLSOptions) (Fetcher, error) {
 	if connectionType == HTTP {
+		tlsConfig, err := NewTLSConfig(tlsOptions)
+		if err != nil {
+			return nil, err
+		}
 		f := HTTPFetcher{URL: url, ConnectionType: connectionType, WebLogicServer: IsWebLogicServer(url),
-			Username: username, ClusterName: clusterName}
+			Username: username, ClusterName: clusterName, Auth: auth, TLS: tlsOptions, tlsConfig: tlsConfig}
+		return f, f.Init()
+	}
+
+	if connectionType == Replay {
+		f, err := NewReplayFetcher(url, username, clusterName)
+		if err != nil {
+			return nil, err
+		}
 		return f, f.Init()
 	}
 
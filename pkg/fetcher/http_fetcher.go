@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2021, 2025 Oracle and/or its affiliates.
+ * Copyright (c) 2021, 2026 Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * https://oss.oracle.com/licenses/upl.
  */
@@ -10,7 +10,6 @@ import (
 	"bufio"
 	"bytes"
 	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -31,15 +30,9 @@ import (
 
 // required to ensure HTTPFetcher implements Fetcher
 var (
-	_                 Fetcher = HTTPFetcher{}
-	username          string
-	password          string
-	caCertPath        string
-	clientCertPath    string
-	clientCertKeyPath string
-
-	certPool     *x509.CertPool
-	certificates = make([]tls.Certificate, 0)
+	_        Fetcher = HTTPFetcher{}
+	username string
+	password string
 )
 
 const (
@@ -70,22 +63,41 @@ type HTTPFetcher struct {
 	WebLogicServer bool
 	Username       string
 	ClusterName    string
+	Auth           *Auth
+	TLS            TLSOptions
+	tlsConfig      *tls.Config
 }
 
-func (h HTTPFetcher) Init() error {
+// Auth contains the credentials for a connection which are used instead of the
+// global username and password and TLS client certificates.
+type Auth struct {
+	Username     string
+	Password     string
+	Token        string
+	Certificates []tls.Certificate
+}
 
-	var err error
+// TLSOptions contains the TLS configuration for a connection. Any paths that are not
+// set default to the values of the COHERENCE_TLS_* environment variables.
+type TLSOptions struct {
+	CACertPath     string
+	ClientCertPath string
+	ClientKeyPath  string
+	MinVersion     string
+	ServerName     string
+}
 
-	certificates, certPool, caCertPath, clientCertPath, clientCertKeyPath, err = utils.GetTLSDetails()
-	if err != nil {
-		return err
-	}
+func (h HTTPFetcher) Init() error {
+	options := h.TLS.withDefaults()
 
-	if DebugEnabled && (clientCertPath != "" || clientCertKeyPath != "" || caCertPath != "") {
+	if DebugEnabled && (options.ClientCertPath != "" || options.ClientKeyPath != "" || options.CACertPath != "" ||
+		options.MinVersion != "" || options.ServerName != "") {
 		fields := []zapcore.Field{
-			zap.String("caCertPath", caCertPath),
-			zap.String("clientCertPath", clientCertPath),
-			zap.String("clientCertKeyPath", clientCertKeyPath),
+			zap.String("caCertPath", options.CACertPath),
+			zap.String("clientCertPath", options.ClientCertPath),
+			zap.String("clientCertKeyPath", options.ClientKeyPath),
+			zap.String("minVersion", options.MinVersion),
+			zap.String("serverName", options.ServerName),
 		}
 		Logger.Info("Init", fields...)
 	}
@@ -93,6 +105,44 @@ func (h HTTPFetcher) Init() error {
 	return nil
 }
 
+// withDefaults returns the options with any paths not set taken from the environment variables.
+func (t TLSOptions) withDefaults() TLSOptions {
+	caCertPath, clientCertPath, clientKeyPath := utils.GetTLSEnvironmentPaths()
+	if t.CACertPath == "" {
+		t.CACertPath = caCertPath
+	}
+	if t.ClientCertPath == "" && t.ClientKeyPath == "" {
+		t.ClientCertPath, t.ClientKeyPath = clientCertPath, clientKeyPath
+	}
+	return t
+}
+
+// NewTLSConfig returns the TLS configuration for the options.
+func NewTLSConfig(options TLSOptions) (*tls.Config, error) {
+	if (options.ClientCertPath == "") != (options.ClientKeyPath == "") {
+		return nil, errors.New("both the client certificate and key must be specified")
+	}
+
+	options = options.withDefaults()
+
+	certificates, certPool, _, _, _, err := utils.GetTLSDetailsFromPaths(options.CACertPath, options.ClientCertPath, options.ClientKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	minVersion, err := utils.GetTLSVersion(options.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: certificates,
+		RootCAs:      certPool,
+		MinVersion:   minVersion, //nolint
+		ServerName:   options.ServerName,
+	}, nil
+}
+
 // GetClusterDetailsJSON returns cluster details in raw json.
 func (h HTTPFetcher) GetClusterDetailsJSON() ([]byte, error) {
 	result, err := httpGetRequest(h, "/?links=")
@@ -805,6 +855,16 @@ func (h HTTPFetcher) GetArchivedSnapshots(serviceName string) ([]byte, error) {
 	return result, nil
 }
 
+// GetArchivedSnapshotStores retrieves the list of stores for an archived snapshot.
+func (h HTTPFetcher) GetArchivedSnapshotStores(serviceName, snapshotName string) ([]byte, error) {
+	result, err := httpGetRequest(h, servicesPath+getSafeServiceName(h, serviceName)+"/persistence/archiveStores/"+
+		getSafeServiceName(h, snapshotName)+"?links=")
+	if err != nil && !strings.Contains(err.Error(), errorCode404) {
+		return constants.EmptyByte, utils.GetError("cannot get archive stores for snapshot "+snapshotName, err)
+	}
+	return result, nil
+}
+
 // InvokeFederationOperation invokes a federation operation against a service and participant.
 func (h HTTPFetcher) InvokeFederationOperation(serviceName, command, participant, mode string) ([]byte, error) {
 	var (
@@ -1222,6 +1282,29 @@ func setUsernamePassword() error {
 	return nil
 }
 
+// getRequestAuth returns the credentials to use for a request, which are the credentials for the
+// connection if set, otherwise the global username and password.
+func (h HTTPFetcher) getRequestAuth() (*Auth, error) {
+	if h.Auth != nil {
+		return h.Auth, nil
+	}
+
+	// if the username and password was sent in then use it
+	if h.Username != "" {
+		username = h.Username
+	}
+
+	// if using WebLogic Server and no username/password then prompt for
+	// Note: In future this may be also if Auth required
+	if h.IsWebLogicServer() && (username == "" || password == "") {
+		if err := setUsernamePassword(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Auth{Username: username, Password: password}, nil
+}
+
 // HttpGetRequest issues a HTTP GET request for the given a relative url.
 func httpGetRequest(h HTTPFetcher, urlAppend string) ([]byte, error) {
 	return httpRequest(h, "GET", urlAppend, false, constants.EmptyByte)
@@ -1253,8 +1336,26 @@ func httpRequest(h HTTPFetcher, requestType, urlAppend string, absolute bool, co
 	return data, err
 }
 
-// HttpRequest issues a HTTP request for the given url and return headers.
+// httpRequestWithHeaders issues a HTTP request for the given url and return headers.
+// If the connection is a recording then the recorded response is returned, and if
+// recording is enabled then successful GET responses are saved.
 func httpRequestWithHeaders(h HTTPFetcher, requestType, urlAppend string, absolute bool, content []byte) ([]byte, http.Header, error) {
+	if h.ConnectionType == Replay {
+		return replayRequest(h, requestType, urlAppend, absolute)
+	}
+
+	data, header, err := executeHTTPRequest(h, requestType, urlAppend, absolute, content)
+	if err == nil && RecordDirectory != "" && requestType == "GET" {
+		if errRecord := recordResponse(h, requestType, urlAppend, absolute, data); errRecord != nil {
+			Logger.Warn("unable to record response", zap.String("url", urlAppend), zap.Error(errRecord))
+		}
+	}
+
+	return data, header, err
+}
+
+// executeHTTPRequest issues a HTTP request for the given url and return headers.
+func executeHTTPRequest(h HTTPFetcher, requestType, urlAppend string, absolute bool, content []byte) ([]byte, http.Header, error) {
 	var (
 		finalURL        string
 		err             error
@@ -1264,20 +1365,27 @@ func httpRequestWithHeaders(h HTTPFetcher, requestType, urlAppend string, absolu
 		unsanitizedBody []byte
 		buffer          bytes.Buffer
 		isJSON          = true
+		reqUsername     string
+		reqPassword     string
+		reqToken        string
+		tlsConfig       *tls.Config
 	)
 
-	// if the username and password was sent in then use it
-	if h.Username != "" {
-		username = h.Username
+	// use the TLS configuration for the connection, or from the environment if not created via GetFetcherWithOptions
+	if h.tlsConfig != nil {
+		tlsConfig = h.tlsConfig.Clone()
+	} else if tlsConfig, err = NewTLSConfig(h.TLS); err != nil {
+		return constants.EmptyByte, nil, err
 	}
+	tlsConfig.InsecureSkipVerify = IgnoreInvalidCerts //nolint
 
-	// if using WebLogic Server and no username/password then prompt for
-	// Note: In future this may be also if Auth required
-	if h.IsWebLogicServer() && (username == "" || password == "") {
-		err = setUsernamePassword()
-		if err != nil {
-			return constants.EmptyByte, nil, err
-		}
+	auth, err := h.getRequestAuth()
+	if err != nil {
+		return constants.EmptyByte, nil, err
+	}
+	reqUsername, reqPassword, reqToken = auth.Username, auth.Password, auth.Token
+	if len(auth.Certificates) > 0 {
+		tlsConfig.Certificates = auth.Certificates
 	}
 
 	if !absolute {
@@ -1313,11 +1421,8 @@ func httpRequestWithHeaders(h HTTPFetcher, requestType, urlAppend string, absolu
 	cookies, _ := cookiejar.New(nil)
 
 	tr := &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: IgnoreInvalidCerts, //nolint
-			Certificates:       certificates,
-			RootCAs:            certPool},
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
 	}
 
 	client := &http.Client{Transport: tr,
@@ -1337,8 +1442,10 @@ func httpRequestWithHeaders(h HTTPFetcher, requestType, urlAppend string, absolu
 		req.Header.Set("X-Requested-By", "Coherence-CLI")
 	}
 
-	if username != "" {
-		req.SetBasicAuth(username, password)
+	if reqToken != "" {
+		req.Header.Set("Authorization", "Bearer "+reqToken)
+	} else if reqUsername != "" {
+		req.SetBasicAuth(reqUsername, reqPassword)
 	}
 
 	if requestType == "POST" && len(content) > 0 {
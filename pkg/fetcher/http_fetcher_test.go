@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package fetcher
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewTLSConfig(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	config, err := NewTLSConfig(TLSOptions{MinVersion: "1.3", ServerName: "coherence.example.com"})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(config.MinVersion).To(gomega.Equal(uint16(tls.VersionTLS13)))
+	g.Expect(config.ServerName).To(gomega.Equal("coherence.example.com"))
+
+	_, err = NewTLSConfig(TLSOptions{MinVersion: "2.0"})
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	_, err = NewTLSConfig(TLSOptions{ClientCertPath: "client.pem"})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestPerConnectionCACert(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testClusterJSON))
+	}))
+	defer server.Close()
+
+	caCertPath := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	g.Expect(os.WriteFile(caCertPath, certPEM, 0600)).To(gomega.Succeed())
+
+	// without the CA the certificate is not trusted
+	f, err := GetFetcherOrError(HTTP, server.URL, "", "")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	_, err = f.GetClusterDetailsJSON()
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	f, err = GetFetcherWithOptions(HTTP, server.URL, "", "", nil, TLSOptions{CACertPath: caCertPath})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	data, err := f.GetClusterDetailsJSON()
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.Equal(testClusterJSON))
+}
+
+func TestResolveAuth(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	savedUsername := username
+	defer func() { username = savedUsername }()
+
+	// the credentials for the connection are used as is
+	auth := &Auth{Username: "admin", Password: "secret"}
+	f, err := ResolveAuth(HTTPFetcher{URL: "http://localhost:30000", Auth: auth})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(f.(HTTPFetcher).Auth).To(gomega.BeIdenticalTo(auth))
+
+	// otherwise the username is resolved into the credentials for the fetcher
+	f, err = ResolveAuth(HTTPFetcher{URL: "http://localhost:30000", Username: "operator"})
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(f.(HTTPFetcher).Auth).To(gomega.Not(gomega.BeNil()))
+	g.Expect(f.(HTTPFetcher).Auth.Username).To(gomega.Equal("operator"))
+}
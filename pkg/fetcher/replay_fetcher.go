@@ -0,0 +1,349 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package fetcher
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oracle/coherence-cli/pkg/utils"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// required to ensure ReplayFetcher implements Fetcher
+var (
+	_ Fetcher = ReplayFetcher{}
+
+	// RecordDirectory is the directory to record all management responses to. If empty then no recording is done.
+	RecordDirectory string
+
+	recordMutex    sync.Mutex
+	recordManifest *ReplayManifest
+
+	replayMutex  sync.Mutex
+	replayStores = make(map[string]*replayStore)
+)
+
+const (
+	// ManifestFileName is the name of the manifest file within a recording.
+	ManifestFileName = "manifest.json"
+
+	responseFileFormat = "response-%05d.%s"
+	notRecordedMessage = "response=404 Not Found, url=%s, response=not present in recording %s"
+)
+
+// ReplayFetcher is an implementation of a Fetcher that serves management responses previously
+// recorded from a cluster. The URL is a directory or a .tar.gz/.tgz archive containing a recording.
+type ReplayFetcher struct {
+	HTTPFetcher
+}
+
+// ReplayManifest describes the contents of a recording.
+type ReplayManifest struct {
+	URL         string            `json:"url"`
+	ClusterName string            `json:"clusterName"`
+	Recorded    string            `json:"recorded"`
+	Responses   map[string]string `json:"responses"`
+}
+
+// replayStore holds a loaded recording.
+type replayStore struct {
+	manifest ReplayManifest
+	files    map[string][]byte
+}
+
+// NewReplayFetcher returns a new ReplayFetcher for the recording at the given path.
+func NewReplayFetcher(recordingPath, username, clusterName string) (ReplayFetcher, error) {
+	store, err := getReplayStore(recordingPath)
+	if err != nil {
+		return ReplayFetcher{}, err
+	}
+
+	if clusterName == "" {
+		clusterName = store.manifest.ClusterName
+	}
+
+	return ReplayFetcher{HTTPFetcher: HTTPFetcher{URL: recordingPath, ConnectionType: Replay,
+		WebLogicServer: IsWebLogicServer(store.manifest.URL), Username: username, ClusterName: clusterName}}, nil
+}
+
+// Init initializes the fetcher. No TLS details are required for a recording.
+func (r ReplayFetcher) Init() error {
+	_, err := getReplayStore(r.URL)
+	return err
+}
+
+// GetReplayManifest returns the manifest for the recording at the given path. For a directory
+// only the manifest is read, so the responses are not loaded until the recording is replayed.
+func GetReplayManifest(recordingPath string) (ReplayManifest, error) {
+	if utils.DirectoryExists(recordingPath) {
+		return readReplayManifest(recordingPath)
+	}
+
+	store, err := getReplayStore(recordingPath)
+	if err != nil {
+		return ReplayManifest{}, err
+	}
+	return store.manifest, nil
+}
+
+// EvictReplayStore removes a loaded recording from memory. The recording is loaded again
+// if it is subsequently replayed.
+func EvictReplayStore(recordingPath string) {
+	replayMutex.Lock()
+	defer replayMutex.Unlock()
+
+	delete(replayStores, recordingPath)
+}
+
+// SetRecordDirectory changes the directory that responses are recorded to, starting a new recording.
+func SetRecordDirectory(directory string) {
+	recordMutex.Lock()
+	defer recordMutex.Unlock()
+
+	RecordDirectory = directory
+	recordManifest = nil
+}
+
+// getReplayStore returns the loaded recording for the path, loading it if required.
+func getReplayStore(recordingPath string) (*replayStore, error) {
+	replayMutex.Lock()
+	defer replayMutex.Unlock()
+
+	if store, ok := replayStores[recordingPath]; ok {
+		return store, nil
+	}
+
+	var (
+		store *replayStore
+		err   error
+	)
+
+	if utils.DirectoryExists(recordingPath) {
+		store, err = loadReplayDirectory(recordingPath)
+	} else if isArchive(recordingPath) {
+		store, err = loadReplayArchive(recordingPath)
+	} else {
+		err = fmt.Errorf("recording %s must be a directory or a .tar.gz/.tgz archive", recordingPath)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	replayStores[recordingPath] = store
+	return store, nil
+}
+
+// readReplayManifest reads the manifest from a recording directory.
+func readReplayManifest(directory string) (ReplayManifest, error) {
+	var manifest ReplayManifest
+
+	manifestData, err := os.ReadFile(filepath.Join(directory, ManifestFileName))
+	if err != nil {
+		return manifest, utils.GetError("unable to read manifest from "+directory, err)
+	}
+
+	if err = json.Unmarshal(manifestData, &manifest); err != nil {
+		return manifest, utils.GetError("unable to unmarshal manifest from "+directory, err)
+	}
+
+	return manifest, nil
+}
+
+// loadReplayDirectory loads a recording from a directory.
+func loadReplayDirectory(directory string) (*replayStore, error) {
+	var (
+		store = &replayStore{files: make(map[string][]byte)}
+		err   error
+	)
+
+	if store.manifest, err = readReplayManifest(directory); err != nil {
+		return nil, err
+	}
+
+	for _, fileName := range store.manifest.Responses {
+		data, err := os.ReadFile(filepath.Join(directory, filepath.Clean(fileName)))
+		if err != nil {
+			return nil, utils.GetError("unable to read recorded response "+fileName, err)
+		}
+		store.files[fileName] = data
+	}
+
+	return store, nil
+}
+
+// loadReplayArchive loads a recording from a .tar.gz archive. The manifest may be in any directory
+// in the archive and all responses are read relative to it.
+func loadReplayArchive(archive string) (*replayStore, error) {
+	var (
+		store    = &replayStore{files: make(map[string][]byte)}
+		contents = make(map[string][]byte)
+		baseDir  = ""
+		found    = false
+	)
+
+	file, err := os.Open(filepath.Clean(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, utils.GetError("unable to read archive "+archive, err)
+	}
+	defer gz.Close()
+
+	tarReader := tar.NewReader(gz)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, utils.GetError("unable to read archive "+archive, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, utils.GetError("unable to read "+header.Name+" from archive", err)
+		}
+
+		name := path.Clean(header.Name)
+		contents[name] = data
+
+		if path.Base(name) == ManifestFileName && !found {
+			baseDir = path.Dir(name)
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("unable to find %s in archive %s", ManifestFileName, archive)
+	}
+
+	if err = json.Unmarshal(contents[path.Join(baseDir, ManifestFileName)], &store.manifest); err != nil {
+		return nil, utils.GetError("unable to unmarshal manifest from "+archive, err)
+	}
+
+	for _, fileName := range store.manifest.Responses {
+		data, ok := contents[path.Join(baseDir, fileName)]
+		if !ok {
+			return nil, fmt.Errorf("recorded response %s not found in archive %s", fileName, archive)
+		}
+		store.files[fileName] = data
+	}
+
+	return store, nil
+}
+
+// isArchive returns true if the path is a file with a .tar.gz or .tgz suffix.
+func isArchive(recordingPath string) bool {
+	return utils.FileExists(recordingPath) &&
+		(strings.HasSuffix(recordingPath, ".tar.gz") || strings.HasSuffix(recordingPath, ".tgz"))
+}
+
+// getRecordingKey returns the key a response is recorded against. The key is made
+// up of the request type and the URL relative to the base URL of the cluster.
+func getRecordingKey(baseURL, requestType, urlAppend string, absolute bool) string {
+	relativeURL := urlAppend
+	if absolute {
+		if strings.HasPrefix(urlAppend, baseURL) {
+			relativeURL = strings.TrimPrefix(urlAppend, baseURL)
+		} else if parsedURL, err := url.Parse(urlAppend); err == nil {
+			// not relative to the cluster, e.g. a health endpoint, so use the host and path
+			relativeURL = parsedURL.Host + parsedURL.RequestURI()
+		}
+	}
+	return requestType + " " + relativeURL
+}
+
+// replayRequest returns a recorded response for the request.
+func replayRequest(h HTTPFetcher, requestType, urlAppend string, absolute bool) ([]byte, http.Header, error) {
+	store, err := getReplayStore(h.URL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if requestType != "GET" {
+		return nil, nil, fmt.Errorf("%s requests are not supported when replaying recording %s", requestType, h.URL)
+	}
+
+	key := getRecordingKey(store.manifest.URL, requestType, urlAppend, absolute)
+
+	if DebugEnabled {
+		Logger.Info("Replay request", zap.String("key", key))
+	}
+
+	fileName, ok := store.manifest.Responses[key]
+	if !ok {
+		return nil, nil, fmt.Errorf(notRecordedMessage, urlAppend, h.URL)
+	}
+
+	return store.files[fileName], http.Header{}, nil
+}
+
+// recordResponse records a successful response in the RecordDirectory. The manifest is
+// re-written after each response so that a recording is usable even if the command is interrupted.
+func recordResponse(h HTTPFetcher, requestType, urlAppend string, absolute bool, data []byte) error {
+	recordMutex.Lock()
+	defer recordMutex.Unlock()
+
+	if recordManifest == nil {
+		if err := utils.EnsureDirectory(RecordDirectory); err != nil {
+			return err
+		}
+
+		recordManifest = &ReplayManifest{URL: h.URL, ClusterName: h.ClusterName,
+			Recorded: time.Now().Format(time.RFC3339), Responses: make(map[string]string)}
+
+		// append to an existing recording if one exists
+		if existing, err := os.ReadFile(filepath.Join(RecordDirectory, ManifestFileName)); err == nil {
+			if err = json.Unmarshal(existing, recordManifest); err != nil {
+				return utils.GetError("unable to unmarshal existing manifest", err)
+			}
+		}
+	}
+
+	key := getRecordingKey(recordManifest.URL, requestType, urlAppend, absolute)
+
+	fileName, ok := recordManifest.Responses[key]
+	if !ok {
+		extension := "json"
+		if strings.Contains(urlAppend, "/getClusterConfig") {
+			extension = "xml"
+		}
+		fileName = fmt.Sprintf(responseFileFormat, len(recordManifest.Responses)+1, extension)
+		recordManifest.Responses[key] = fileName
+	}
+
+	if err := os.WriteFile(filepath.Join(RecordDirectory, fileName), data, 0600); err != nil {
+		return utils.GetError("unable to write recorded response "+fileName, err)
+	}
+
+	manifestData, err := json.MarshalIndent(recordManifest, "", "  ")
+	if err != nil {
+		return utils.GetError("unable to marshal manifest", err)
+	}
+
+	return os.WriteFile(filepath.Join(RecordDirectory, ManifestFileName), manifestData, 0600)
+}
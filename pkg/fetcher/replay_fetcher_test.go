@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026 Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * https://oss.oracle.com/licenses/upl.
+ */
+
+package fetcher
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testBaseURL     = "http://localhost:30000/management/coherence/cluster"
+	testClusterJSON = `{"clusterName":"my-cluster","version":"14.1.2.0.0"}`
+	testMembersJSON = `{"items":[{"nodeId":"1"}]}`
+)
+
+func TestGetRecordingKey(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	g.Expect(getRecordingKey(testBaseURL, "GET", "/?links=", false)).To(gomega.Equal("GET /?links="))
+	g.Expect(getRecordingKey(testBaseURL, "GET", testBaseURL+"/members/1", true)).To(gomega.Equal("GET /members/1"))
+	g.Expect(getRecordingKey(testBaseURL, "GET", "http://127.0.0.1:6676/ready", true)).To(gomega.Equal("GET 127.0.0.1:6676/ready"))
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	var (
+		g   = gomega.NewGomegaWithT(t)
+		h   = HTTPFetcher{URL: testBaseURL, ConnectionType: HTTP, ClusterName: "my-cluster"}
+		dir = filepath.Join(t.TempDir(), "recording")
+	)
+
+	recordTestResponses(g, h, dir)
+
+	f, err := GetFetcherOrError(Replay, dir, "", "")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(f.GetType()).To(gomega.Equal(Replay))
+
+	data, err := f.GetClusterDetailsJSON()
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.Equal(testClusterJSON))
+
+	data, err = f.GetMemberDetailsJSON(true)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.Equal(testMembersJSON))
+
+	// responses not recorded should return a 404
+	_, err = f.GetManagementJSON()
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(strings.Contains(err.Error(), errorCode404)).To(gomega.BeTrue())
+
+	// operations are not supported
+	_, err = f.ShutdownMember("1")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestReplayFromArchive(t *testing.T) {
+	var (
+		g       = gomega.NewGomegaWithT(t)
+		h       = HTTPFetcher{URL: testBaseURL, ConnectionType: HTTP, ClusterName: "my-cluster"}
+		tempDir = t.TempDir()
+		dir     = filepath.Join(tempDir, "recording")
+		archive = filepath.Join(tempDir, "recording.tar.gz")
+	)
+
+	recordTestResponses(g, h, dir)
+	g.Expect(createTestArchive(dir, archive)).To(gomega.Succeed())
+
+	f, err := GetFetcherOrError(Replay, archive, "", "")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+	data, err := f.GetClusterDetailsJSON()
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.Equal(testClusterJSON))
+
+	_, err = GetFetcherOrError(Replay, filepath.Join(tempDir, "missing"), "", "")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+// recordTestResponses records responses to the given directory.
+func recordTestResponses(g *gomega.WithT, h HTTPFetcher, dir string) {
+	RecordDirectory = dir
+	recordManifest = nil
+	defer func() {
+		RecordDirectory = ""
+		recordManifest = nil
+	}()
+
+	g.Expect(recordResponse(h, "GET", "/?links=", false, []byte(testClusterJSON))).To(gomega.Succeed())
+	g.Expect(recordResponse(h, "GET", testBaseURL+"/members/?links=", true, []byte(testMembersJSON))).To(gomega.Succeed())
+}
+
+// createTestArchive creates a tar.gz archive of a directory with the files under a "recording" directory.
+func createTestArchive(dir, archive string) error {
+	file, err := os.Create(archive)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	defer gz.Close()
+	tw := tar.NewWriter(gz)
+	defer tw.Close()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		header := &tar.Header{Name: "recording/" + entry.Name(), Mode: 0600, Size: int64(len(data)), Typeflag: tar.TypeReg}
+		if err = tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err = tw.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestReplayManifestIsReadLazily(t *testing.T) {
+	var (
+		g   = gomega.NewGomegaWithT(t)
+		h   = HTTPFetcher{URL: testBaseURL, ConnectionType: HTTP, ClusterName: "my-cluster"}
+		dir = filepath.Join(t.TempDir(), "recording")
+	)
+
+	recordTestResponses(g, h, dir)
+
+	manifest, err := GetReplayManifest(dir)
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(manifest.ClusterName).To(gomega.Equal("my-cluster"))
+	g.Expect(isReplayStoreLoaded(dir)).To(gomega.BeFalse())
+
+	f, err := NewReplayFetcher(dir, "", "")
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(isReplayStoreLoaded(dir)).To(gomega.BeTrue())
+
+	EvictReplayStore(dir)
+	g.Expect(isReplayStoreLoaded(dir)).To(gomega.BeFalse())
+
+	// an evicted recording is loaded again when replayed
+	data, err := f.GetClusterDetailsJSON()
+	g.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	g.Expect(string(data)).To(gomega.Equal(testClusterJSON))
+}
+
+// isReplayStoreLoaded returns true if the recording is loaded in memory.
+func isReplayStoreLoaded(recordingPath string) bool {
+	replayMutex.Lock()
+	defer replayMutex.Unlock()
+	_, ok := replayStores[recordingPath]
+	return ok
+}
@@ -484,6 +484,20 @@ func GetBackupCount(ownership map[int]*config.PartitionOwnership) int {
 }
 
 func GetTLSDetails() ([]tls.Certificate, *x509.CertPool, string, string, string, error) {
+	caCertPath, clientCertPath, clientCertKeyPath := GetTLSEnvironmentPaths()
+	return GetTLSDetailsFromPaths(caCertPath, clientCertPath, clientCertKeyPath)
+}
+
+// GetTLSEnvironmentPaths returns the CA certificate, client certificate and client key
+// paths from the environment variables.
+func GetTLSEnvironmentPaths() (string, string, string) {
+	return GetStringValueFromEnvVarOrDefault(envTLSCertPath, ""),
+		GetStringValueFromEnvVarOrDefault(envTLSClientCert, ""),
+		GetStringValueFromEnvVarOrDefault(envTLSClientKey, "")
+}
+
+// GetTLSDetailsFromPaths returns the client certificates and CA certificate pool for the given paths.
+func GetTLSDetailsFromPaths(caCertPath, clientCertPath, clientCertKeyPath string) ([]tls.Certificate, *x509.CertPool, string, string, string, error) {
 	var (
 		certPool     *x509.CertPool
 		certData     []byte
@@ -491,10 +505,6 @@ func GetTLSDetails() ([]tls.Certificate, *x509.CertPool, string, string, string,
 		err          error
 	)
 
-	caCertPath := GetStringValueFromEnvVarOrDefault(envTLSCertPath, "")
-	clientCertPath := GetStringValueFromEnvVarOrDefault(envTLSClientCert, "")
-	clientCertKeyPath := GetStringValueFromEnvVarOrDefault(envTLSClientKey, "")
-
 	if caCertPath != "" {
 		certPool = x509.NewCertPool()
 
@@ -530,6 +540,24 @@ func GetTLSDetails() ([]tls.Certificate, *x509.CertPool, string, string, string,
 	return certificates, certPool, caCertPath, clientCertPath, clientCertKeyPath, nil
 }
 
+// GetTLSVersion returns the TLS version for a version string such as "1.2", or 0 if empty.
+func GetTLSVersion(version string) (uint16, error) {
+	switch version {
+	case "":
+		return 0, nil
+	case "1.0":
+		return tls.VersionTLS10, nil
+	case "1.1":
+		return tls.VersionTLS11, nil
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("invalid TLS version %s, must be one of 1.0, 1.1, 1.2 or 1.3", version)
+	}
+}
+
 // validateFilePath checks to see if a file path is valid.
 func validateFilePath(file string) error {
 	if _, err := os.Stat(file); err == nil {
@@ -1,7 +1,7 @@
 #!/bin/bash
 
 #
-# Copyright (c) 2021, 2025 Oracle and/or its affiliates.
+# Copyright (c) 2021, 2026 Oracle and/or its affiliates.
 # Licensed under the Universal Permissive License v 1.0 as shown at
 # https://oss.oracle.com/licenses/upl.
 #
@@ -53,7 +53,12 @@ create_doc $DOCS_DIR/get_default_style "${COHCTL} get default-style --help"
 create_doc $DOCS_DIR/add_panel "${COHCTL} add panel --help"
 create_doc $DOCS_DIR/get_panels "${COHCTL} get panels --help"
 create_doc $DOCS_DIR/remove_panel "${COHCTL} remove panel --help"
+create_doc $DOCS_DIR/add_rule "${COHCTL} add rule --help"
+create_doc $DOCS_DIR/get_rules "${COHCTL} get rules --help"
+create_doc $DOCS_DIR/remove_rule "${COHCTL} remove rule --help"
 create_doc $DOCS_DIR/set_cluster "${COHCTL} set cluster --help"
+create_doc $DOCS_DIR/diff_clusters "${COHCTL} diff clusters --help"
+create_doc $DOCS_DIR/get_capacity "${COHCTL} get capacity --help"
 
 (
 echo "// # tag::text[]"
@@ -69,6 +74,9 @@ create_doc $DOCS_DIR/scale_cluster "${COHCTL} scale cluster --help"
 create_doc $DOCS_DIR/start_cluster "${COHCTL} start cluster --help"
 create_doc $DOCS_DIR/stop_cluster "${COHCTL} stop cluster --help"
 create_doc $DOCS_DIR/restart_cluster "${COHCTL} restart cluster --help"
+create_doc $DOCS_DIR/rolling_restart_cluster "${COHCTL} rolling-restart cluster --help"
+create_doc $DOCS_DIR/get_processes "${COHCTL} get processes --help"
+create_doc $DOCS_DIR/supervise_cluster "${COHCTL} supervise cluster --help"
 create_doc $DOCS_DIR/start_console "${COHCTL} start console --help"
 create_doc $DOCS_DIR/start_cohql "${COHCTL} start cohql --help"
 create_doc $DOCS_DIR/start_class "${COHCTL} start class --help"
@@ -88,6 +96,7 @@ create_doc $DOCS_DIR/get_p2p_stats "${COHCTL} get p2p-stats --help"
 create_doc $DOCS_DIR/describe_member "${COHCTL} describe member --help"
 create_doc $DOCS_DIR/set_member "${COHCTL} set member --help"
 create_doc $DOCS_DIR/shutdown_member "${COHCTL} shutdown member --help"
+create_doc $DOCS_DIR/rolling_shutdown_cluster "${COHCTL} rolling-shutdown cluster --help"
 create_doc $DOCS_DIR/get_member_description "${COHCTL} get member-description --help"
 
 # Machines
@@ -118,6 +127,7 @@ create_doc $DOCS_DIR/shutdown_service "${COHCTL} shutdown service --help"
 create_doc $DOCS_DIR/suspend_service "${COHCTL} suspend service --help"
 create_doc $DOCS_DIR/resume_service "${COHCTL} resume service --help"
 create_doc $DOCS_DIR/get_service_description "${COHCTL} get service-description --help"
+create_doc $DOCS_DIR/analyze_partitions "${COHCTL} analyze partitions --help"
 
 # Management
 create_doc $DOCS_DIR/get_management "${COHCTL} get management --help"
@@ -128,6 +138,7 @@ create_doc $DOCS_DIR/init_monitoring "${COHCTL} init monitoring --help"
 create_doc $DOCS_DIR/start_monitoring "${COHCTL} start monitoring --help"
 create_doc $DOCS_DIR/stop_monitoring "${COHCTL} stop monitoring --help"
 create_doc $DOCS_DIR/get_monitoring "${COHCTL} get monitoring --help"
+create_doc $DOCS_DIR/serve_metrics "${COHCTL} serve metrics --help"
 
 # Caches
 create_doc $DOCS_DIR/get_caches "${COHCTL} get caches --help"
@@ -136,7 +147,10 @@ create_doc $DOCS_DIR/get_cache_storage "${COHCTL} get cache-storage --help"
 create_doc $DOCS_DIR/get_cache_access "${COHCTL} get cache-access --help"
 create_doc $DOCS_DIR/get_cache_indexes "${COHCTL} get cache-indexes --help"
 create_doc $DOCS_DIR/get_cache_partitions "${COHCTL} get cache-partitions --help"
+create_doc $DOCS_DIR/analyze_cache "${COHCTL} analyze cache --help"
 create_doc $DOCS_DIR/describe_cache "${COHCTL} describe cache --help"
+create_doc $DOCS_DIR/describe_cache_config "${COHCTL} describe cache-config --help"
+create_doc $DOCS_DIR/validate_cache_config "${COHCTL} validate cache-config --help"
 create_doc $DOCS_DIR/set_cache "${COHCTL} set cache --help"
 create_doc $DOCS_DIR/truncate_cache "${COHCTL} truncate cache --help"
 create_doc $DOCS_DIR/clear_cache "${COHCTL} clear cache --help"
@@ -257,9 +271,14 @@ create_doc $DOCS_DIR/version "${COHCTL} version --help"
 
 # Logs
 create_doc $DOCS_DIR/get_logs "${COHCTL} get logs --help"
+create_doc $DOCS_DIR/analyze_logs "${COHCTL} analyze logs --help"
 
 # Thread Dump
 create_doc $DOCS_DIR/retrieve_thread_dumps "${COHCTL} retrieve thread-dumps --help"
+create_doc $DOCS_DIR/analyze_thread_dumps "${COHCTL} analyze thread-dumps --help"
+
+# Diagnostic Bundle
+create_doc $DOCS_DIR/create_diagnostic_bundle "${COHCTL} create diagnostic-bundle --help"
 
 # Reset Stats
 create_doc $DOCS_DIR/reset_cache_stats "${COHCTL} reset cache-stats --help"
@@ -278,8 +297,10 @@ create_doc $DOCS_DIR/get_snapshots "${COHCTL} get snapshots --help"
 create_doc $DOCS_DIR/create_snapshot "${COHCTL} create snapshot --help"
 create_doc $DOCS_DIR/remove_snapshot "${COHCTL} remove snapshot --help"
 create_doc $DOCS_DIR/recover_snapshot "${COHCTL} recover snapshot --help"
+create_doc $DOCS_DIR/check_snapshot "${COHCTL} check snapshot --help"
 create_doc $DOCS_DIR/archive_snapshot "${COHCTL} archive snapshot --help"
 create_doc $DOCS_DIR/retrieve_snapshot "${COHCTL} retrieve snapshot --help"
+create_doc $DOCS_DIR/schedule_snapshot "${COHCTL} schedule snapshot --help"
 create_doc $DOCS_DIR/force_recovery "${COHCTL} force recovery --help"
 
 # Config